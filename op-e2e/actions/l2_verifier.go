@@ -127,6 +127,14 @@ func (s *l2VerifierBackend) SequencerActive(ctx context.Context) (bool, error) {
 	return false, nil
 }
 
+func (s *l2VerifierBackend) PauseDerivation(ctx context.Context) error {
+	return errors.New("pausing the L2Verifier derivation is not supported")
+}
+
+func (s *l2VerifierBackend) ResumeDerivation(ctx context.Context) error {
+	return errors.New("resuming the L2Verifier derivation is not supported")
+}
+
 func (s *L2Verifier) L2Finalized() eth.L2BlockRef {
 	return s.derivation.Finalized()
 }
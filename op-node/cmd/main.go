@@ -79,11 +79,10 @@ func RollupNodeMain(ctx *cli.Context, closeApp context.CancelCauseFunc) (cliapp.
 	opservice.WarnOnDeprecatedFlags(ctx, flags.DeprecatedFlags, log)
 	m := metrics.NewMetrics("default")
 
-	cfg, err := opnode.NewConfig(ctx, log)
+	cfg, err := opnode.NewConfig(ctx, log, closeApp)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create the rollup node config: %w", err)
 	}
-	cfg.Cancel = closeApp
 
 	snapshotLog, err := opnode.NewSnapshotLogger(ctx)
 	if err != nil {
@@ -102,5 +101,11 @@ func RollupNodeMain(ctx *cli.Context, closeApp context.CancelCauseFunc) (cliapp.
 		return nil, fmt.Errorf("unable to create the rollup node: %w", err)
 	}
 
+	if ctx.Bool(flags.ReloadOnSIGHUP.Name) {
+		go node.ReloadOnSignal(ctx.Context, n, func() (*node.Config, error) {
+			return opnode.NewConfig(ctx, log, closeApp)
+		})
+	}
+
 	return n, nil
 }
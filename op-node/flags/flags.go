@@ -7,6 +7,7 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
+	"github.com/ethereum-optimism/optimism/op-service/client"
 	openum "github.com/ethereum-optimism/optimism/op-service/enum"
 	opflags "github.com/ethereum-optimism/optimism/op-service/flags"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
@@ -69,6 +70,17 @@ var (
 		Usage:   "Enable the admin API (experimental)",
 		EnvVars: prefixEnvVars("RPC_ENABLE_ADMIN"),
 	}
+	RPCHealthyLagBlocks = &cli.Uint64Flag{
+		Name:    "rpc.healthy-lag-blocks",
+		Usage:   "Number of L1 blocks the derivation may lag behind the L1 head, for /healthz and /ready to report the node as healthy. Disabled if 0.",
+		EnvVars: prefixEnvVars("RPC_HEALTHY_LAG_BLOCKS"),
+	}
+	RPCHealthyLagGrace = &cli.DurationFlag{
+		Name:    "rpc.healthy-lag-grace",
+		Usage:   "How long the derivation has to lag continuously by more than rpc.healthy-lag-blocks, before /healthz and /ready report the node as unhealthy.",
+		EnvVars: prefixEnvVars("RPC_HEALTHY_LAG_GRACE"),
+		Value:   time.Minute,
+	}
 	RPCAdminPersistence = &cli.StringFlag{
 		Name:    "rpc.admin-state",
 		Usage:   "File path used to persist state changes made via the admin API so they persist across restarts. Disabled if not set.",
@@ -89,6 +101,106 @@ var (
 			return &out
 		}(),
 	}
+	L1CacheSize = &cli.UintFlag{
+		Name: "l1.cache-size",
+		Usage: "Number of L1 blocks to cache headers, receipts and transactions for. " +
+			"Defaults to 3/2 of the sequencing window size, capped at 1000, if set to 0. At most 10000. Higher values increase memory usage.",
+		EnvVars: prefixEnvVars("L1_CACHE_SIZE"),
+		Value:   0,
+	}
+	L1JWTSecret = &cli.StringFlag{
+		Name:    "l1.jwt-secret",
+		Usage:   "Optional path to JWT secret key, to authenticate with the L1 RPC. Keys are 32 bytes, hex encoded in a file. Disabled if not set.",
+		EnvVars: prefixEnvVars("L1_JWT_SECRET"),
+	}
+	L1TLSCaCert = &cli.StringFlag{
+		Name:    "l1.tls.ca",
+		Usage:   "Optional path to a CA bundle to verify the L1 RPC server with. The system CA pool is used if not set.",
+		EnvVars: prefixEnvVars("L1_TLS_CA"),
+	}
+	L1TLSCert = &cli.StringFlag{
+		Name:    "l1.tls.cert",
+		Usage:   "Optional path to a TLS client certificate, to authenticate with the L1 RPC using mutual TLS. Requires l1.tls.key.",
+		EnvVars: prefixEnvVars("L1_TLS_CERT"),
+	}
+	L1TLSKey = &cli.StringFlag{
+		Name:    "l1.tls.key",
+		Usage:   "Optional path to the TLS client key for l1.tls.cert.",
+		EnvVars: prefixEnvVars("L1_TLS_KEY"),
+	}
+	L2TLSCaCert = &cli.StringFlag{
+		Name:    "l2.tls.ca",
+		Usage:   "Optional path to a CA bundle to verify the L2 Engine RPC server with. The system CA pool is used if not set.",
+		EnvVars: prefixEnvVars("L2_TLS_CA"),
+	}
+	L2TLSCert = &cli.StringFlag{
+		Name:    "l2.tls.cert",
+		Usage:   "Optional path to a TLS client certificate, to authenticate with the L2 Engine RPC using mutual TLS. Requires l2.tls.key.",
+		EnvVars: prefixEnvVars("L2_TLS_CERT"),
+	}
+	L2TLSKey = &cli.StringFlag{
+		Name:    "l2.tls.key",
+		Usage:   "Optional path to the TLS client key for l2.tls.cert.",
+		EnvVars: prefixEnvVars("L2_TLS_KEY"),
+	}
+	L1SlowRequestThreshold = &cli.DurationFlag{
+		Name:    "l1.slow-request-threshold",
+		Usage:   "Log a warning for every L1 RPC request that takes longer than this. Disabled if 0. Adjustable at runtime with admin_setL1SlowRequestThreshold.",
+		EnvVars: prefixEnvVars("L1_SLOW_REQUEST_THRESHOLD"),
+	}
+	L1MaxHeadAge = &cli.DurationFlag{
+		Name:    "l1.max-head-age",
+		Usage:   "Report the node as degraded if no newer L1 head arrives within this duration, e.g. due to a stuck L1 provider. Disabled if 0.",
+		EnvVars: prefixEnvVars("L1_MAX_HEAD_AGE"),
+	}
+	L1MaxHeadAgeReadiness = &cli.BoolFlag{
+		Name:    "l1.max-head-age-readiness",
+		Usage:   "Report the node as not ready on the /ready endpoint while the L1 head is older than l1.max-head-age.",
+		EnvVars: prefixEnvVars("L1_MAX_HEAD_AGE_READINESS"),
+	}
+	L1RPCHeader = &cli.StringSliceFlag{
+		Name:    "l1.rpc-header",
+		Usage:   "Custom HTTP header to send with every L1 RPC request, as \"Name: value\", e.g. for a provider API key. Can be repeated.",
+		EnvVars: prefixEnvVars("L1_RPC_HEADER"),
+	}
+	L2RPCHeader = &cli.StringSliceFlag{
+		Name:    "l2.rpc-header",
+		Usage:   "Custom HTTP header to send with every L2 Engine RPC request, as \"Name: value\". Can be repeated.",
+		EnvVars: prefixEnvVars("L2_RPC_HEADER"),
+	}
+	L1HTTPProxy = &cli.StringFlag{
+		Name:    "l1.http-proxy",
+		Usage:   "Optional HTTP(S) or SOCKS5 proxy URL for the L1 RPC connection. Defaults to the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.",
+		EnvVars: prefixEnvVars("L1_HTTP_PROXY"),
+	}
+	L2HTTPProxy = &cli.StringFlag{
+		Name:    "l2.http-proxy",
+		Usage:   "Optional HTTP(S) or SOCKS5 proxy URL for the L2 Engine RPC connection. Defaults to the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.",
+		EnvVars: prefixEnvVars("L2_HTTP_PROXY"),
+	}
+	L2EnginePayloadTimeout = &cli.DurationFlag{
+		Name:    "l2.engine-payload-timeout",
+		Usage:   "Timeout of each engine_forkchoiceUpdated and engine_newPayload call to the L2 Engine. Timed out calls are retried.",
+		EnvVars: prefixEnvVars("L2_ENGINE_PAYLOAD_TIMEOUT"),
+		Value:   sources.DefaultEnginePayloadTimeout,
+	}
+	L1DialTimeout = &cli.DurationFlag{
+		Name:    "l1.dial-timeout",
+		Usage:   "Timeout of each attempt to dial the L1 RPC. Timed out attempts are retried.",
+		EnvVars: prefixEnvVars("L1_DIAL_TIMEOUT"),
+		Value:   client.DefaultDialAttemptTimeout,
+	}
+	L2DialTimeout = &cli.DurationFlag{
+		Name:    "l2.dial-timeout",
+		Usage:   "Timeout of each attempt to dial the L2 Engine RPC. Timed out attempts are retried.",
+		EnvVars: prefixEnvVars("L2_DIAL_TIMEOUT"),
+		Value:   client.DefaultDialAttemptTimeout,
+	}
+	L2CheckModules = &cli.BoolFlag{
+		Name:    "l2.check-modules",
+		Usage:   "Check at start-up that the L2 Engine RPC serves the engine and eth namespaces, as reported by rpc_modules. Skipped with a warning if rpc_modules fails.",
+		EnvVars: prefixEnvVars("L2_CHECK_MODULES"),
+	}
 	L1RethDBPath = &cli.StringFlag{
 		Name:    "l1.rethdb",
 		Usage:   "The L1 RethDB path, used to fetch receipts for L1 blocks. Only applicable when using the `reth_db` RPC kind with `l1.rpckind`.",
@@ -97,7 +209,7 @@ var (
 	}
 	L1RPCMaxConcurrency = &cli.IntFlag{
 		Name:    "l1.max-concurrency",
-		Usage:   "Maximum number of concurrent RPC requests to make to the L1 RPC provider.",
+		Usage:   "Maximum number of concurrent RPC requests to make to the L1 RPC provider. Must be at least 1. Values above 128 are capped to 128.",
 		EnvVars: prefixEnvVars("L1_MAX_CONCURRENCY"),
 		Value:   10,
 	}
@@ -159,6 +271,17 @@ var (
 		EnvVars: prefixEnvVars("L1_RUNTIME_CONFIG_RELOAD_INTERVAL"),
 		Value:   time.Minute * 10,
 	}
+	SyncProgressLogInterval = &cli.DurationFlag{
+		Name:    "log.sync-progress-interval",
+		Usage:   "Interval at which to log the derivation progress and ETA, while catching up with L1. Disabled if 0.",
+		EnvVars: prefixEnvVars("LOG_SYNC_PROGRESS_INTERVAL"),
+		Value:   time.Minute,
+	}
+	StatusLogInterval = &cli.DurationFlag{
+		Name:    "log.status-interval",
+		Usage:   "Interval at which to log the L1 head, the derivation lag and the derived L2 heads. Disabled if 0.",
+		EnvVars: prefixEnvVars("LOG_STATUS_INTERVAL"),
+	}
 	MetricsEnabledFlag = &cli.BoolFlag{
 		Name:    "metrics.enabled",
 		Usage:   "Enable the metrics server",
@@ -219,6 +342,17 @@ var (
 		Usage:   "Opt-in option to halt on incompatible protocol version requirements of the given level (major/minor/patch/none), as signaled onchain in L1",
 		EnvVars: prefixEnvVars("ROLLUP_HALT"),
 	}
+	ExitAfterCatchUp = &cli.BoolFlag{
+		Name:    "exit-after-catchup",
+		Usage:   "Exit once derivation has caught up with the L1 head as seen at start-up, e.g. to generate a snapshot. The node shuts down gracefully and exits with code 0, like on an interrupt. Not compatible with sequencing.",
+		EnvVars: prefixEnvVars("EXIT_AFTER_CATCHUP"),
+	}
+	ReloadOnSIGHUP = &cli.BoolFlag{
+		Name: "reload-on-sighup",
+		Usage: "Reload the config on SIGHUP, instead of exiting, and apply the settings that can change at runtime: " +
+			"the L1 slow-request threshold and the status log intervals. Changes to other settings fail the reload.",
+		EnvVars: prefixEnvVars("RELOAD_ON_SIGHUP"),
+	}
 	RollupLoadProtocolVersions = &cli.BoolFlag{
 		Name:    "rollup.load-protocol-versions",
 		Usage:   "Load protocol versions from the superchain L1 ProtocolVersions contract (if available), and report in logs and metrics",
@@ -277,6 +411,25 @@ var optionalFlags = []cli.Flag{
 	L1RPCMaxBatchSize,
 	L1RPCMaxConcurrency,
 	L1HTTPPollInterval,
+	L1CacheSize,
+	L1JWTSecret,
+	L1TLSCaCert,
+	L1TLSCert,
+	L1TLSKey,
+	L2TLSCaCert,
+	L2TLSCert,
+	L2TLSKey,
+	L1RPCHeader,
+	L2RPCHeader,
+	L1HTTPProxy,
+	L1SlowRequestThreshold,
+	L1MaxHeadAge,
+	L1MaxHeadAgeReadiness,
+	L2HTTPProxy,
+	L2EnginePayloadTimeout,
+	L1DialTimeout,
+	L2DialTimeout,
+	L2CheckModules,
 	VerifierL1Confs,
 	SequencerEnabledFlag,
 	SequencerStoppedFlag,
@@ -284,7 +437,11 @@ var optionalFlags = []cli.Flag{
 	SequencerL1Confs,
 	L1EpochPollIntervalFlag,
 	RuntimeConfigReloadIntervalFlag,
+	SyncProgressLogInterval,
+	StatusLogInterval,
 	RPCEnableAdmin,
+	RPCHealthyLagBlocks,
+	RPCHealthyLagGrace,
 	RPCAdminPersistence,
 	MetricsEnabledFlag,
 	MetricsAddrFlag,
@@ -298,6 +455,8 @@ var optionalFlags = []cli.Flag{
 	HeartbeatURLFlag,
 	RollupHalt,
 	RollupLoadProtocolVersions,
+	ExitAfterCatchUp,
+	ReloadOnSIGHUP,
 	L1RethDBPath,
 }
 
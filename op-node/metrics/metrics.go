@@ -107,6 +107,9 @@ type Metrics struct {
 
 	L1RequestDurationSeconds *prometheus.HistogramVec
 
+	EngineForkchoiceUpdatesTotal          *prometheus.CounterVec
+	EngineForkchoiceUpdateDurationSeconds prometheus.Histogram
+
 	SequencerBuildingDiffDurationSeconds prometheus.Histogram
 	SequencerBuildingDiffTotal           prometheus.Counter
 
@@ -341,6 +344,18 @@ func NewMetrics(procName string) *Metrics {
 			Help: "Histogram of L1 request time",
 		}, []string{"request"}),
 
+		EngineForkchoiceUpdatesTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "engine_forkchoice_updates_total",
+			Help:      "Count of forkchoice updates sent to the L2 engine, by payload status, or 'error' if the call failed",
+		}, []string{"status"}),
+		EngineForkchoiceUpdateDurationSeconds: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "engine_forkchoice_update_seconds",
+			Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+			Help:      "Histogram of L2 engine forkchoice update time",
+		}),
+
 		SequencerBuildingDiffDurationSeconds: factory.NewHistogram(prometheus.HistogramOpts{
 			Namespace: ns,
 			Name:      "sequencer_building_diff_seconds",
@@ -517,6 +532,12 @@ func (m *Metrics) RecordL1RequestTime(method string, duration time.Duration) {
 	m.L1RequestDurationSeconds.WithLabelValues(method).Observe(float64(duration) / float64(time.Second))
 }
 
+// RecordForkchoiceUpdate tracks the resulting payload status and duration of a forkchoice update to the L2 engine.
+func (m *Metrics) RecordForkchoiceUpdate(status string, duration time.Duration) {
+	m.EngineForkchoiceUpdatesTotal.WithLabelValues(status).Inc()
+	m.EngineForkchoiceUpdateDurationSeconds.Observe(float64(duration) / float64(time.Second))
+}
+
 // RecordSequencerBuildingDiffTime tracks the amount of time the sequencer was allowed between
 // start to finish, incl. sealing, minus the block time.
 // Ideally this is 0, realistically the sequencer scheduler may be busy with other jobs like syncing sometimes.
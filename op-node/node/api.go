@@ -2,7 +2,9 @@ package node
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -10,6 +12,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/version"
+	"github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/rpc"
@@ -30,11 +33,25 @@ type driverClient interface {
 	StartSequencer(ctx context.Context, blockHash common.Hash) error
 	StopSequencer(context.Context) (common.Hash, error)
 	SequencerActive(context.Context) (bool, error)
+	PauseDerivation(context.Context) error
+	ResumeDerivation(context.Context) error
 }
 
 type adminAPI struct {
 	*rpc.CommonAdminAPI
 	dr driverClient
+
+	l1SlowLog *client.SlowLoggingClient // optional, to adjust the slow L1 request logging
+	l1Head    l1HeadRefresher           // optional, to refresh the L1 head on demand
+	ancestor  commonAncestorFinder      // optional, to find the common ancestor of the engine and L1
+}
+
+type l1HeadRefresher interface {
+	RefreshL1Head(ctx context.Context) (eth.L1BlockRef, error)
+}
+
+type commonAncestorFinder interface {
+	CommonAncestor(ctx context.Context) (eth.L2BlockRef, error)
 }
 
 func NewAdminAPI(dr driverClient, m metrics.RPCMetricer, log log.Logger) *adminAPI {
@@ -68,6 +85,59 @@ func (n *adminAPI) SequencerActive(ctx context.Context) (bool, error) {
 	return n.dr.SequencerActive(ctx)
 }
 
+func (n *adminAPI) PauseDerivation(ctx context.Context) error {
+	recordDur := n.M.RecordRPCServerRequest("admin_pauseDerivation")
+	defer recordDur()
+	return n.dr.PauseDerivation(ctx)
+}
+
+func (n *adminAPI) ResumeDerivation(ctx context.Context) error {
+	recordDur := n.M.RecordRPCServerRequest("admin_resumeDerivation")
+	defer recordDur()
+	return n.dr.ResumeDerivation(ctx)
+}
+
+// SetL1SlowRequestThreshold changes the duration, e.g. "500ms", after which L1 requests are logged as slow.
+// A threshold of 0 disables the logging.
+func (n *adminAPI) SetL1SlowRequestThreshold(ctx context.Context, threshold string) error {
+	recordDur := n.M.RecordRPCServerRequest("admin_setL1SlowRequestThreshold")
+	defer recordDur()
+	if n.l1SlowLog == nil {
+		return errors.New("slow L1 request logging is not available")
+	}
+	dur, err := time.ParseDuration(threshold)
+	if err != nil {
+		return fmt.Errorf("invalid threshold: %w", err)
+	}
+	if dur < 0 {
+		return fmt.Errorf("threshold cannot be negative: %s", dur)
+	}
+	n.l1SlowLog.SetThreshold(dur)
+	return nil
+}
+
+// RefreshL1Head re-fetches the latest L1 block, e.g. when the L1 head subscription lags behind,
+// and signals it to the driver if it is ahead of the current L1 head. This returns the resulting L1 head.
+func (n *adminAPI) RefreshL1Head(ctx context.Context) (eth.L1BlockRef, error) {
+	recordDur := n.M.RecordRPCServerRequest("admin_refreshL1Head")
+	defer recordDur()
+	if n.l1Head == nil {
+		return eth.L1BlockRef{}, errors.New("refreshing the L1 head is not available")
+	}
+	return n.l1Head.RefreshL1Head(ctx)
+}
+
+// CommonAncestor returns the highest L2 block of the engine of which the L1 origin is still canonical,
+// i.e. where the engine's chain and the chain derived from L1 last agree. This is meant for debugging divergences.
+func (n *adminAPI) CommonAncestor(ctx context.Context) (eth.L2BlockRef, error) {
+	recordDur := n.M.RecordRPCServerRequest("admin_commonAncestor")
+	defer recordDur()
+	if n.ancestor == nil {
+		return eth.L2BlockRef{}, errors.New("finding the common ancestor is not available")
+	}
+	return n.ancestor.CommonAncestor(ctx)
+}
+
 type nodeAPI struct {
 	config *rollup.Config
 	client l2EthClient
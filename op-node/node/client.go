@@ -2,8 +2,14 @@ package node
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
@@ -13,6 +19,14 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	gn "github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+)
+
+var (
+	// ErrMissingEndpoint is returned when the address of a required RPC endpoint is not configured.
+	ErrMissingEndpoint = errors.New("missing endpoint address")
+	// ErrInvalidEndpoint is returned when the address of an RPC endpoint is malformed or has an unsupported scheme.
+	ErrInvalidEndpoint = errors.New("invalid endpoint address")
 )
 
 type L2EndpointSetup interface {
@@ -35,13 +49,54 @@ type L2EndpointConfig struct {
 	// JWT secrets for L2 Engine API authentication during HTTP or initial Websocket communication.
 	// Any value for an IPC connection.
 	L2EngineJWTSecret [32]byte
+
+	// TLS optionally configures the TLS client settings of the L2 Engine connection.
+	TLS ClientTLSConfig
+
+	// HTTPProxy is an optional proxy URL for the L2 Engine HTTP and websocket connection.
+	// The standard proxy environment variables are used if empty.
+	HTTPProxy string
+
+	// Headers are optional custom HTTP headers to send with every L2 Engine HTTP request and websocket handshake.
+	Headers http.Header
+
+	// L2EnginePayloadTimeout bounds each engine_forkchoiceUpdated and engine_newPayload call.
+	// The sources.DefaultEnginePayloadTimeout is used if zero.
+	L2EnginePayloadTimeout time.Duration
+
+	// DialTimeout bounds each attempt to dial the L2 Engine.
+	// The client.DefaultDialAttemptTimeout is used if zero.
+	DialTimeout time.Duration
+
+	// CheckModules checks at start-up that the L2 Engine serves the engine and eth namespaces, with rpc_modules.
+	CheckModules bool
 }
 
 var _ L2EndpointSetup = (*L2EndpointConfig)(nil)
 
 func (cfg *L2EndpointConfig) Check() error {
 	if cfg.L2EngineAddr == "" {
-		return errors.New("empty L2 Engine Address")
+		return fmt.Errorf("%w for L2 Engine", ErrMissingEndpoint)
+	}
+	if err := validateEndpoint(cfg.L2EngineAddr); err != nil {
+		return fmt.Errorf("%w for L2 Engine: %w", ErrInvalidEndpoint, err)
+	}
+	if err := cfg.TLS.Check(); err != nil {
+		return fmt.Errorf("invalid L2 Engine TLS config: %w", err)
+	}
+	if cfg.HTTPProxy != "" {
+		if err := validateProxy(cfg.HTTPProxy); err != nil {
+			return fmt.Errorf("invalid L2 Engine HTTP proxy: %w", err)
+		}
+	}
+	if err := validateHeaders(cfg.Headers, true); err != nil {
+		return fmt.Errorf("invalid L2 Engine headers: %w", err)
+	}
+	if cfg.L2EnginePayloadTimeout < 0 {
+		return fmt.Errorf("negative L2 Engine payload timeout: %s", cfg.L2EnginePayloadTimeout)
+	}
+	if cfg.DialTimeout < 0 {
+		return fmt.Errorf("negative L2 Engine dial timeout: %s", cfg.DialTimeout)
 	}
 
 	return nil
@@ -56,12 +111,57 @@ func (cfg *L2EndpointConfig) Setup(ctx context.Context, log log.Logger, rollupCf
 		client.WithGethRPCOptions(auth),
 		client.WithDialBackoff(10),
 	}
+	if len(cfg.Headers) > 0 {
+		opts = append(opts, client.WithGethRPCOptions(rpc.WithHeaders(cfg.Headers)))
+	}
+	if cfg.DialTimeout != 0 {
+		opts = append(opts, client.WithDialAttemptTimeout(cfg.DialTimeout))
+	}
+	if transportOpt, err := transportOption(&cfg.TLS, cfg.HTTPProxy); err != nil {
+		return nil, nil, fmt.Errorf("failed to load L2 Engine transport config: %w", err)
+	} else if transportOpt != nil {
+		opts = append(opts, transportOpt)
+	}
 	l2Node, err := client.NewRPC(ctx, log, cfg.L2EngineAddr, opts...)
 	if err != nil {
 		return nil, nil, err
 	}
+	if cfg.CheckModules {
+		if err := checkRPCModules(ctx, log, l2Node, requiredL2Modules); err != nil {
+			l2Node.Close()
+			return nil, nil, fmt.Errorf("invalid L2 Engine RPC: %w", err)
+		}
+	}
 
-	return l2Node, sources.EngineClientDefaultConfig(rollupCfg), nil
+	engineCfg := sources.EngineClientDefaultConfig(rollupCfg)
+	if cfg.L2EnginePayloadTimeout != 0 {
+		engineCfg.PayloadTimeout = cfg.L2EnginePayloadTimeout
+	}
+	return l2Node, engineCfg, nil
+}
+
+// requiredL2Modules are the RPC namespaces that the op-node uses on the L2 execution engine.
+var requiredL2Modules = []string{"engine", "eth"}
+
+// checkRPCModules checks that the RPC serves all the required namespaces, as reported by rpc_modules.
+// It only errors if a required namespace is definitely missing: if rpc_modules fails,
+// e.g. because it is not supported, or the RPC is still starting, the check is skipped with a warning.
+func checkRPCModules(ctx context.Context, log log.Logger, cl client.RPC, required []string) error {
+	var modules map[string]string
+	if err := cl.CallContext(ctx, &modules, "rpc_modules"); err != nil {
+		log.Warn("Failed to query RPC modules, cannot check its namespaces", "err", err)
+		return nil
+	}
+	var missing []string
+	for _, m := range required {
+		if _, ok := modules[m]; !ok {
+			missing = append(missing, m)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("RPC does not serve the required namespaces %v, it serves: %v", missing, modules)
+	}
+	return nil
 }
 
 // PreparedL2Endpoints enables testing with in-process pre-setup RPC connections to L2 engines
@@ -82,6 +182,14 @@ func (p *PreparedL2Endpoints) Setup(ctx context.Context, log log.Logger, rollupC
 	return p.Client, sources.EngineClientDefaultConfig(rollupCfg), nil
 }
 
+// maxL1Concurrency is a sanity cap on concurrent L1 requests, to not accidentally overwhelm the L1 RPC.
+// Higher settings are capped with a warning, rather than rejected, to not break existing deployments.
+const maxL1Concurrency = 128
+
+// maxL1CacheSize is a sanity limit on the number of L1 blocks to cache data for, since the caches hold
+// full receipts and transactions per block, and a mistyped size could exhaust the memory of the node.
+const maxL1CacheSize = 10_000
+
 type L1EndpointConfig struct {
 	L1NodeAddr string // Address of L1 User JSON-RPC endpoint to use (eth namespace required)
 
@@ -108,11 +216,42 @@ type L1EndpointConfig struct {
 	// It is recommended to use websockets or IPC for efficient following of the changing block.
 	// Setting this to 0 disables polling.
 	HttpPollInterval time.Duration
+
+	// CacheSize specifies the number of L1 blocks to cache headers, receipts and transactions for.
+	// Receipts and transactions are cached per block, so a single size applies to all of these caches.
+	// If 0, the cache size defaults to 3/2 of the sequencing window size, capped at 1000.
+	// It may be at most maxL1CacheSize.
+	CacheSize uint
+
+	// L1JWTSecret is an optional JWT secret for L1 RPC authentication during HTTP or initial Websocket communication.
+	// Authentication is disabled if nil. Ignored for IPC connections.
+	L1JWTSecret *[32]byte
+
+	// TLS optionally configures the TLS client settings of the L1 connection.
+	TLS ClientTLSConfig
+
+	// HTTPProxy is an optional proxy URL for the L1 HTTP and websocket connection.
+	// The standard proxy environment variables are used if empty.
+	HTTPProxy string
+
+	// Headers are optional custom HTTP headers to send with every L1 HTTP request and websocket handshake,
+	// e.g. to authenticate with a provider API key.
+	Headers http.Header
+
+	// DialTimeout bounds each attempt to dial the L1 RPC.
+	// The client.DefaultDialAttemptTimeout is used if zero.
+	DialTimeout time.Duration
 }
 
 var _ L1EndpointSetup = (*L1EndpointConfig)(nil)
 
 func (cfg *L1EndpointConfig) Check() error {
+	if cfg.L1NodeAddr == "" {
+		return fmt.Errorf("%w for L1 Node", ErrMissingEndpoint)
+	}
+	if err := validateEndpoint(cfg.L1NodeAddr); err != nil {
+		return fmt.Errorf("%w for L1 Node: %w", ErrInvalidEndpoint, err)
+	}
 	if cfg.BatchSize < 1 || cfg.BatchSize > 500 {
 		return fmt.Errorf("batch size is invalid or unreasonable: %d", cfg.BatchSize)
 	}
@@ -122,6 +261,23 @@ func (cfg *L1EndpointConfig) Check() error {
 	if cfg.MaxConcurrency < 1 {
 		return fmt.Errorf("max concurrent requests cannot be less than 1, was %d", cfg.MaxConcurrency)
 	}
+	if cfg.DialTimeout < 0 {
+		return fmt.Errorf("dial timeout cannot be negative: %s", cfg.DialTimeout)
+	}
+	if cfg.CacheSize > maxL1CacheSize {
+		return fmt.Errorf("cache size cannot be more than %d blocks, was %d", maxL1CacheSize, cfg.CacheSize)
+	}
+	if err := cfg.TLS.Check(); err != nil {
+		return fmt.Errorf("invalid L1 TLS config: %w", err)
+	}
+	if cfg.HTTPProxy != "" {
+		if err := validateProxy(cfg.HTTPProxy); err != nil {
+			return fmt.Errorf("invalid L1 HTTP proxy: %w", err)
+		}
+	}
+	if err := validateHeaders(cfg.Headers, cfg.L1JWTSecret != nil); err != nil {
+		return fmt.Errorf("invalid L1 headers: %w", err)
+	}
 	return nil
 }
 
@@ -130,20 +286,197 @@ func (cfg *L1EndpointConfig) Setup(ctx context.Context, log log.Logger, rollupCf
 		client.WithHttpPollInterval(cfg.HttpPollInterval),
 		client.WithDialBackoff(10),
 	}
+	if cfg.L1JWTSecret != nil {
+		opts = append(opts, client.WithGethRPCOptions(rpc.WithHTTPAuth(gn.NewJWTAuth(*cfg.L1JWTSecret))))
+	}
+	if len(cfg.Headers) > 0 {
+		opts = append(opts, client.WithGethRPCOptions(rpc.WithHeaders(cfg.Headers)))
+	}
 	if cfg.RateLimit != 0 {
 		opts = append(opts, client.WithRateLimit(cfg.RateLimit, cfg.BatchSize))
 	}
+	if cfg.DialTimeout != 0 {
+		opts = append(opts, client.WithDialAttemptTimeout(cfg.DialTimeout))
+	}
+	if transportOpt, err := transportOption(&cfg.TLS, cfg.HTTPProxy); err != nil {
+		return nil, nil, fmt.Errorf("failed to load L1 transport config: %w", err)
+	} else if transportOpt != nil {
+		opts = append(opts, transportOpt)
+	}
 
 	l1Node, err := client.NewRPC(ctx, log, cfg.L1NodeAddr, opts...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to dial L1 address (%s): %w", cfg.L1NodeAddr, err)
 	}
 	rpcCfg := sources.L1ClientDefaultConfig(rollupCfg, cfg.L1TrustRPC, cfg.L1RPCKind)
+	if cfg.CacheSize > 0 {
+		rpcCfg.SetBlockCacheSize(int(cfg.CacheSize))
+	}
 	rpcCfg.MaxRequestsPerBatch = cfg.BatchSize
-	rpcCfg.MaxConcurrentRequests = cfg.MaxConcurrency
+	rpcCfg.MaxConcurrentRequests = capL1Concurrency(log, cfg.MaxConcurrency)
 	return l1Node, rpcCfg, nil
 }
 
+// capL1Concurrency caps the max concurrent L1 requests to maxL1Concurrency, and warns if it does.
+func capL1Concurrency(log log.Logger, maxConcurrency int) int {
+	if maxConcurrency > maxL1Concurrency {
+		log.Warn("Capping max concurrent L1 requests", "configured", maxConcurrency, "max", maxL1Concurrency)
+		return maxL1Concurrency
+	}
+	return maxConcurrency
+}
+
+// validateEndpoint checks that the RPC address is either a HTTP(S) or WS(S) URL with a host, or a path to an IPC socket.
+func validateEndpoint(addr string) error {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return err
+	}
+	switch u.Scheme {
+	case "http", "https", "ws", "wss":
+		if u.Host == "" {
+			return fmt.Errorf("no host in %s address %q", u.Scheme, addr)
+		}
+		return nil
+	case "": // no scheme: the RPC client treats the address as an IPC socket path
+		return nil
+	default:
+		return fmt.Errorf("unsupported scheme %q in address %q, expected http, https, ws, wss or an IPC path", u.Scheme, addr)
+	}
+}
+
+// validateHeaders checks that the custom headers have valid names and values.
+// With JWT auth, the Authorization header is reserved for the JWT token.
+func validateHeaders(headers http.Header, jwtAuth bool) error {
+	for name, values := range headers {
+		if name == "" || strings.IndexFunc(name, func(r rune) bool { return !isHeaderTokenChar(r) }) >= 0 {
+			return fmt.Errorf("invalid header name %q", name)
+		}
+		if jwtAuth && http.CanonicalHeaderKey(name) == "Authorization" {
+			return errors.New("the Authorization header cannot be set together with JWT auth")
+		}
+		for _, v := range values {
+			if strings.ContainsAny(v, "\r\n") {
+				return fmt.Errorf("invalid value for header %q: contains a line break", name)
+			}
+		}
+	}
+	return nil
+}
+
+// isHeaderTokenChar reports whether r is allowed in a header name, see the token definition of RFC 7230.
+func isHeaderTokenChar(r rune) bool {
+	return r < 0x7f && (('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') ||
+		strings.ContainsRune("!#$%&'*+-.^_`|~", r))
+}
+
+// ClientTLSConfig configures TLS for an RPC client, e.g. to connect to endpoints that require mutual TLS.
+// All fields are paths to PEM-encoded files. The settings only apply to https and wss endpoints.
+type ClientTLSConfig struct {
+	// CACert is an optional CA bundle to verify the server with. The system CA pool is used if empty.
+	CACert string
+	// Cert and Key are the optional client certificate and key to authenticate with.
+	// Either both or neither must be set.
+	Cert string
+	Key  string
+}
+
+// Enabled returns true if any TLS setting is configured.
+func (c *ClientTLSConfig) Enabled() bool {
+	return c.CACert != "" || c.Cert != "" || c.Key != ""
+}
+
+func (c *ClientTLSConfig) Check() error {
+	if (c.Cert == "") != (c.Key == "") {
+		return errors.New("tls client cert and key must be set together")
+	}
+	for _, p := range []string{c.CACert, c.Cert, c.Key} {
+		if p == "" {
+			continue
+		}
+		if _, err := os.Stat(p); err != nil {
+			return fmt.Errorf("tls file %q is not accessible: %w", p, err)
+		}
+	}
+	return nil
+}
+
+// tlsConfig loads the configured CA bundle and client key-pair.
+func (c *ClientTLSConfig) tlsConfig() (*tls.Config, error) {
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if c.CACert != "" {
+		caCert, err := os.ReadFile(c.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tls ca cert: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no valid certificates in tls ca cert %q", c.CACert)
+		}
+		tlsCfg.RootCAs = pool
+	}
+	if c.Cert != "" {
+		cert, err := tls.LoadX509KeyPair(c.Cert, c.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load tls client key-pair: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+	return tlsCfg, nil
+}
+
+// transportOption applies the TLS config and HTTP proxy to both the HTTP and the websocket transport of the RPC client.
+// The proxy defaults to the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables, if not set.
+// This returns nil if neither TLS nor a proxy is configured, to keep the default RPC transport.
+func transportOption(tlsConf *ClientTLSConfig, proxy string) (client.RPCOption, error) {
+	if !tlsConf.Enabled() && proxy == "" {
+		return nil, nil
+	}
+	var tlsCfg *tls.Config
+	if tlsConf.Enabled() {
+		var err error
+		if tlsCfg, err = tlsConf.tlsConfig(); err != nil {
+			return nil, err
+		}
+	}
+	proxyFn := http.ProxyFromEnvironment
+	if proxy != "" {
+		proxyURL, err := url.Parse(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid http proxy: %w", err)
+		}
+		proxyFn = http.ProxyURL(proxyURL)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsCfg
+	transport.Proxy = proxyFn
+	// start from the default dialer settings, like the HTTP transport does, and only change the proxy and TLS
+	dialer := *websocket.DefaultDialer
+	dialer.Proxy = proxyFn
+	dialer.TLSClientConfig = tlsCfg
+	return client.WithGethRPCOptions(
+		rpc.WithHTTPClient(&http.Client{Transport: transport}),
+		rpc.WithWebsocketDialer(dialer),
+	), nil
+}
+
+// validateProxy checks that the proxy address is a HTTP(S) or SOCKS5 URL with a host.
+func validateProxy(addr string) error {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return err
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+		if u.Host == "" {
+			return fmt.Errorf("no host in proxy address %q", addr)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported scheme %q in proxy address %q, expected http, https or socks5", u.Scheme, addr)
+	}
+}
+
 // PreparedL1Endpoint enables testing with an in-process pre-setup RPC connection to L1
 type PreparedL1Endpoint struct {
 	Client          client.RPC
@@ -0,0 +1,304 @@
+package node
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestValidateEndpoint(t *testing.T) {
+	tests := []struct {
+		addr  string
+		valid bool
+	}{
+		{addr: "http://127.0.0.1:8545", valid: true},
+		{addr: "https://example.com/v1/key", valid: true},
+		{addr: "ws://localhost:8546", valid: true},
+		{addr: "wss://example.com", valid: true},
+		{addr: "/tmp/geth.ipc", valid: true},
+		{addr: "geth.ipc", valid: true},
+		{addr: "http://", valid: false},
+		{addr: "wss:///path", valid: false},
+		{addr: "ftp://example.com", valid: false},
+		{addr: "ipc://geth.ipc", valid: false},
+		{addr: "http://[::1", valid: false},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.addr, func(t *testing.T) {
+			err := validateEndpoint(test.addr)
+			if test.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestL1EndpointConfigCheck(t *testing.T) {
+	valid := func() *L1EndpointConfig {
+		return &L1EndpointConfig{
+			L1NodeAddr:     "http://127.0.0.1:8545",
+			BatchSize:      20,
+			MaxConcurrency: 10,
+		}
+	}
+	require.NoError(t, valid().Check())
+
+	tests := []struct {
+		name   string
+		modify func(cfg *L1EndpointConfig)
+	}{
+		{name: "empty address", modify: func(cfg *L1EndpointConfig) { cfg.L1NodeAddr = "" }},
+		{name: "bad scheme", modify: func(cfg *L1EndpointConfig) { cfg.L1NodeAddr = "tcp://127.0.0.1:8545" }},
+		{name: "zero batch size", modify: func(cfg *L1EndpointConfig) { cfg.BatchSize = 0 }},
+		{name: "negative rate limit", modify: func(cfg *L1EndpointConfig) { cfg.RateLimit = -1 }},
+		{name: "zero concurrency", modify: func(cfg *L1EndpointConfig) { cfg.MaxConcurrency = 0 }},
+		{name: "negative dial timeout", modify: func(cfg *L1EndpointConfig) { cfg.DialTimeout = -time.Second }},
+		{name: "excessive cache size", modify: func(cfg *L1EndpointConfig) { cfg.CacheSize = maxL1CacheSize + 1 }},
+		{name: "tls cert without key", modify: func(cfg *L1EndpointConfig) { cfg.TLS.Cert = "client.crt" }},
+		{name: "bad proxy scheme", modify: func(cfg *L1EndpointConfig) { cfg.HTTPProxy = "ftp://proxy:21" }},
+		{name: "proxy without host", modify: func(cfg *L1EndpointConfig) { cfg.HTTPProxy = "http://" }},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			cfg := valid()
+			test.modify(cfg)
+			require.Error(t, cfg.Check())
+		})
+	}
+}
+
+func TestCapL1Concurrency(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	logs := testlog.Capture(logger)
+	require.Equal(t, 10, capL1Concurrency(logger, 10))
+	require.Nil(t, logs.FindLog(log.LvlWarn, "Capping max concurrent L1 requests"))
+	require.Equal(t, maxL1Concurrency, capL1Concurrency(logger, maxL1Concurrency+1))
+	require.NotNil(t, logs.FindLog(log.LvlWarn, "Capping max concurrent L1 requests"))
+}
+
+func TestL2EndpointConfigCheck(t *testing.T) {
+	require.NoError(t, (&L2EndpointConfig{L2EngineAddr: "ws://127.0.0.1:8551"}).Check())
+	require.Error(t, (&L2EndpointConfig{}).Check())
+	require.Error(t, (&L2EndpointConfig{L2EngineAddr: "127.0.0.1:8551"}).Check())
+	require.Error(t, (&L2EndpointConfig{L2EngineAddr: "ws://127.0.0.1:8551", DialTimeout: -time.Second}).Check())
+}
+
+func TestValidateHeaders(t *testing.T) {
+	require.NoError(t, validateHeaders(nil, true))
+	require.NoError(t, validateHeaders(http.Header{"X-Api-Key": {"secret"}}, true))
+	require.NoError(t, validateHeaders(http.Header{"Authorization": {"Bearer secret"}}, false))
+	require.ErrorContains(t, validateHeaders(http.Header{"Authorization": {"Bearer secret"}}, true), "JWT")
+	require.ErrorContains(t, validateHeaders(http.Header{"X Api Key": {"secret"}}, false), "invalid header name")
+	require.ErrorContains(t, validateHeaders(http.Header{"": {"secret"}}, false), "invalid header name")
+	require.ErrorContains(t, validateHeaders(http.Header{"X-Api-Key": {"secret\r\nX-Other: 1"}}, false), "line break")
+}
+
+func TestL2EndpointConfigHeaders(t *testing.T) {
+	rpcSrv := rpc.NewServer()
+	t.Cleanup(rpcSrv.Stop)
+	require.NoError(t, rpcSrv.RegisterName("engine", testRPCService{}))
+	require.NoError(t, rpcSrv.RegisterName("eth", testRPCService{}))
+	var apiKey atomic.Value
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiKey.Store(r.Header.Get("X-Api-Key"))
+		rpcSrv.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	// the modules check makes a request during setup
+	cfg := &L2EndpointConfig{L2EngineAddr: srv.URL, Headers: http.Header{"X-Api-Key": {"secret"}}, CheckModules: true}
+	cl, _, err := cfg.Setup(context.Background(), testlog.Logger(t, log.LvlInfo), &rollup.Config{})
+	require.NoError(t, err)
+	defer cl.Close()
+	require.Equal(t, "secret", apiKey.Load())
+}
+
+func TestClientTLSConfigCheck(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeTestKeyPair(t, dir, "client")
+
+	require.False(t, (&ClientTLSConfig{}).Enabled())
+	require.NoError(t, (&ClientTLSConfig{}).Check())
+	require.NoError(t, (&ClientTLSConfig{CACert: certFile}).Check())
+	require.NoError(t, (&ClientTLSConfig{Cert: certFile, Key: keyFile}).Check())
+	require.ErrorContains(t, (&ClientTLSConfig{Key: keyFile}).Check(), "must be set together")
+	require.ErrorContains(t, (&ClientTLSConfig{CACert: filepath.Join(dir, "missing.crt")}).Check(), "not accessible")
+}
+
+func TestClientTLSConfigMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	serverCert, serverKey := writeTestKeyPair(t, dir, "server")
+	clientCert, clientKey := writeTestKeyPair(t, dir, "client")
+
+	clientPool := x509.NewCertPool()
+	clientPEM, err := os.ReadFile(clientCert)
+	require.NoError(t, err)
+	require.True(t, clientPool.AppendCertsFromPEM(clientPEM))
+	serverPair, err := tls.LoadX509KeyPair(serverCert, serverKey)
+	require.NoError(t, err)
+
+	rpcSrv := rpc.NewServer()
+	t.Cleanup(rpcSrv.Stop)
+	srv := httptest.NewUnstartedServer(rpcSrv)
+	srv.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverPair},
+		ClientCAs:    clientPool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	lgr := testlog.Logger(t, log.LvlInfo)
+	call := func(cfg ClientTLSConfig) error {
+		tlsOpt, err := transportOption(&cfg, "")
+		require.NoError(t, err)
+		cl, err := client.NewRPC(context.Background(), lgr, srv.URL, tlsOpt)
+		require.NoError(t, err)
+		defer cl.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		var modules map[string]string
+		return cl.CallContext(ctx, &modules, "rpc_modules")
+	}
+
+	require.NoError(t, call(ClientTLSConfig{CACert: serverCert, Cert: clientCert, Key: clientKey}))
+	require.Error(t, call(ClientTLSConfig{CACert: serverCert}), "server requires a client certificate")
+}
+
+func TestTransportOptionProxy(t *testing.T) {
+	opt, err := transportOption(&ClientTLSConfig{}, "")
+	require.NoError(t, err)
+	require.Nil(t, opt, "default transport without TLS or proxy")
+
+	rpcSrv := rpc.NewServer()
+	t.Cleanup(rpcSrv.Stop)
+	srv := httptest.NewServer(rpcSrv)
+	t.Cleanup(srv.Close)
+	target, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	var proxied atomic.Int32
+	forward := httputil.NewSingleHostReverseProxy(target)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied.Add(1)
+		forward.ServeHTTP(w, r)
+	}))
+	t.Cleanup(proxy.Close)
+
+	opt, err = transportOption(&ClientTLSConfig{}, proxy.URL)
+	require.NoError(t, err)
+	cl, err := client.NewRPC(context.Background(), testlog.Logger(t, log.LvlInfo), srv.URL, opt)
+	require.NoError(t, err)
+	defer cl.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var modules map[string]string
+	require.NoError(t, cl.CallContext(ctx, &modules, "rpc_modules"))
+	require.Equal(t, int32(1), proxied.Load())
+}
+
+// writeTestKeyPair writes a self-signed localhost certificate and its key to dir, and returns the file paths.
+func writeTestKeyPair(t *testing.T, dir string, name string) (certFile, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile = filepath.Join(dir, name+".crt")
+	keyFile = filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	return certFile, keyFile
+}
+
+type testRPCService struct{}
+
+func (testRPCService) Ping() string { return "pong" }
+
+type methodNotFoundRPC struct {
+	client.RPC
+}
+
+type methodNotFoundErr struct{}
+
+func (methodNotFoundErr) Error() string  { return "the method rpc_modules does not exist/is not available" }
+func (methodNotFoundErr) ErrorCode() int { return -32601 }
+
+func (methodNotFoundRPC) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	return methodNotFoundErr{}
+}
+
+type failingRPC struct {
+	client.RPC
+}
+
+func (failingRPC) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	return io.ErrUnexpectedEOF
+}
+
+func TestCheckRPCModules(t *testing.T) {
+	lgr := testlog.Logger(t, log.LvlInfo)
+	dial := func(namespaces ...string) client.RPC {
+		rpcSrv := rpc.NewServer()
+		t.Cleanup(rpcSrv.Stop)
+		for _, ns := range namespaces {
+			require.NoError(t, rpcSrv.RegisterName(ns, testRPCService{}))
+		}
+		srv := httptest.NewServer(rpcSrv)
+		t.Cleanup(srv.Close)
+		cl, err := client.NewRPC(context.Background(), lgr, srv.URL)
+		require.NoError(t, err)
+		t.Cleanup(cl.Close)
+		return cl
+	}
+	ctx := context.Background()
+
+	require.NoError(t, checkRPCModules(ctx, lgr, dial("engine", "eth"), requiredL2Modules))
+	require.ErrorContains(t, checkRPCModules(ctx, lgr, dial("eth"), requiredL2Modules), "[engine]")
+	require.ErrorContains(t, checkRPCModules(ctx, lgr, dial(), requiredL2Modules), "[engine eth]")
+	require.NoError(t, checkRPCModules(ctx, lgr, methodNotFoundRPC{}, requiredL2Modules), "skip check if rpc_modules is not supported")
+	require.NoError(t, checkRPCModules(ctx, lgr, failingRPC{}, requiredL2Modules), "skip check if rpc_modules fails")
+}
@@ -16,6 +16,19 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 )
 
+// Errors returned by Config.Check, each wrapping the underlying error of that part of the config.
+// The rollup config error wraps the rollup package errors, e.g. rollup.ErrGenesisHashesSame.
+var (
+	ErrL1Config          = errors.New("l1 endpoint config error")
+	ErrL2Config          = errors.New("l2 endpoint config error")
+	ErrRollupConfig      = errors.New("rollup config error")
+	ErrMetricsConfig     = errors.New("metrics config error")
+	ErrRPCConfig         = errors.New("rpc config error")
+	ErrPprofConfig       = errors.New("pprof config error")
+	ErrP2PConfig         = errors.New("p2p config error")
+	ErrInvalidRollupHalt = errors.New("invalid rollup halting option")
+)
+
 type Config struct {
 	L1 L1EndpointSetup
 	L2 L2EndpointSetup
@@ -47,6 +60,14 @@ type Config struct {
 	// but if log-events are not coming in (e.g. not syncing blocks) then the reload ensures the config stays accurate.
 	RuntimeConfigReloadInterval time.Duration
 
+	// SyncProgressLogInterval is the interval at which the derivation progress and ETA are logged,
+	// while catching up with L1. Disabled if 0.
+	SyncProgressLogInterval time.Duration
+
+	// StatusLogInterval is the interval at which the L1 head, the derivation origin and its lag,
+	// and the derived L2 heads are logged. Disabled if 0.
+	StatusLogInterval time.Duration
+
 	// Optional
 	Tracer    Tracer
 	Heartbeat HeartbeatConfig
@@ -60,14 +81,42 @@ type Config struct {
 	// Cancel to request a premature shutdown of the node itself, e.g. when halting. This may be nil.
 	Cancel context.CancelCauseFunc
 
+	// ExitAfterCatchUp shuts down the node, through Cancel, once derivation has reached
+	// the L1 head as seen when the node started. E.g. to generate a snapshot.
+	// The shutdown is graceful, and the op-node exits with code 0, like on an interrupt.
+	ExitAfterCatchUp bool
+
 	// [OPTIONAL] The reth DB path to read receipts from
 	RethDBPath string
+
+	// L1SlowRequestThreshold is the duration after which L1 RPC requests are logged as slow. Disabled if 0.
+	// It can be changed at runtime with the admin_setL1SlowRequestThreshold RPC.
+	L1SlowRequestThreshold time.Duration
+
+	// MaxL1HeadAge is how long the node may go without a new L1 head with a newer timestamp,
+	// before it is considered degraded, e.g. due to a stuck L1 provider. Disabled if 0.
+	MaxL1HeadAge time.Duration
+	// MaxL1HeadAgeReadiness reports the node as not ready on the /ready endpoint, while the L1 head is stale.
+	MaxL1HeadAgeReadiness bool
 }
 
 type RPCConfig struct {
 	ListenAddr  string
 	ListenPort  int
 	EnableAdmin bool
+
+	// HealthyLagBlocks is the number of L1 blocks the derivation may lag behind the L1 head,
+	// for the /healthz and /ready endpoints to report the node as healthy. Lag checks are disabled if 0.
+	HealthyLagBlocks uint64
+	// HealthyLagGrace is how long the derivation has to lag continuously, before the node is reported unhealthy.
+	HealthyLagGrace time.Duration
+}
+
+func (cfg *RPCConfig) Check() error {
+	if cfg.HealthyLagGrace < 0 {
+		return fmt.Errorf("health lag grace period cannot be negative: %s", cfg.HealthyLagGrace)
+	}
+	return nil
 }
 
 func (cfg *RPCConfig) HttpEndpoint() string {
@@ -119,27 +168,53 @@ func (cfg *Config) LoadPersisted(log log.Logger) error {
 // Check verifies that the given configuration makes sense
 func (cfg *Config) Check() error {
 	if err := cfg.L1.Check(); err != nil {
-		return fmt.Errorf("l2 endpoint config error: %w", err)
+		return fmt.Errorf("%w: %w", ErrL1Config, err)
 	}
 	if err := cfg.L2.Check(); err != nil {
-		return fmt.Errorf("l2 endpoint config error: %w", err)
+		return fmt.Errorf("%w: %w", ErrL2Config, err)
 	}
 	if err := cfg.Rollup.Check(); err != nil {
-		return fmt.Errorf("rollup config error: %w", err)
+		return fmt.Errorf("%w: %w", ErrRollupConfig, err)
 	}
 	if err := cfg.Metrics.Check(); err != nil {
-		return fmt.Errorf("metrics config error: %w", err)
+		return fmt.Errorf("%w: %w", ErrMetricsConfig, err)
+	}
+	if err := cfg.RPC.Check(); err != nil {
+		return fmt.Errorf("%w: %w", ErrRPCConfig, err)
+	}
+	if cfg.SyncProgressLogInterval < 0 {
+		return fmt.Errorf("sync progress log interval cannot be negative: %s", cfg.SyncProgressLogInterval)
+	}
+	if cfg.StatusLogInterval < 0 {
+		return fmt.Errorf("status log interval cannot be negative: %s", cfg.StatusLogInterval)
+	}
+	if cfg.L1SlowRequestThreshold < 0 {
+		return fmt.Errorf("l1 slow request threshold cannot be negative: %s", cfg.L1SlowRequestThreshold)
+	}
+	if cfg.ExitAfterCatchUp {
+		if cfg.Cancel == nil {
+			return errors.New("exiting after catch-up requires node cancellation to be available")
+		}
+		if cfg.Driver.SequencerEnabled {
+			return errors.New("cannot exit after catch-up when sequencing")
+		}
+	}
+	if cfg.MaxL1HeadAge < 0 {
+		return fmt.Errorf("max l1 head age cannot be negative: %s", cfg.MaxL1HeadAge)
+	}
+	if cfg.MaxL1HeadAgeReadiness && cfg.MaxL1HeadAge == 0 {
+		return errors.New("l1 head age readiness requires a max l1 head age")
 	}
 	if err := cfg.Pprof.Check(); err != nil {
-		return fmt.Errorf("pprof config error: %w", err)
+		return fmt.Errorf("%w: %w", ErrPprofConfig, err)
 	}
 	if cfg.P2P != nil {
 		if err := cfg.P2P.Check(); err != nil {
-			return fmt.Errorf("p2p config error: %w", err)
+			return fmt.Errorf("%w: %w", ErrP2PConfig, err)
 		}
 	}
 	if !(cfg.RollupHalt == "" || cfg.RollupHalt == "major" || cfg.RollupHalt == "minor" || cfg.RollupHalt == "patch") {
-		return fmt.Errorf("invalid rollup halting option: %q", cfg.RollupHalt)
+		return fmt.Errorf("%w: %q", ErrInvalidRollupHalt, cfg.RollupHalt)
 	}
 	return nil
 }
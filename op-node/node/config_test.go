@@ -0,0 +1,55 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
+)
+
+func TestConfigCheckErrors(t *testing.T) {
+	validL1 := func() *L1EndpointConfig {
+		return &L1EndpointConfig{L1NodeAddr: "http://127.0.0.1:8545", BatchSize: 20, MaxConcurrency: 10}
+	}
+	validL2 := func() *L2EndpointConfig {
+		return &L2EndpointConfig{L2EngineAddr: "http://127.0.0.1:8551"}
+	}
+
+	t.Run("missing L1 endpoint", func(t *testing.T) {
+		cfg := &Config{L1: &L1EndpointConfig{BatchSize: 20, MaxConcurrency: 10}, L2: validL2()}
+		err := cfg.Check()
+		require.ErrorIs(t, err, ErrL1Config)
+		require.ErrorIs(t, err, ErrMissingEndpoint)
+		require.EqualError(t, err, "l1 endpoint config error: missing endpoint address for L1 Node")
+	})
+	t.Run("invalid L2 endpoint", func(t *testing.T) {
+		cfg := &Config{L1: validL1(), L2: &L2EndpointConfig{L2EngineAddr: "ftp://127.0.0.1:8551"}}
+		err := cfg.Check()
+		require.ErrorIs(t, err, ErrL2Config)
+		require.ErrorIs(t, err, ErrInvalidEndpoint)
+		require.NotErrorIs(t, err, ErrL1Config)
+	})
+	t.Run("bad rollup config", func(t *testing.T) {
+		cfg := &Config{L1: validL1(), L2: validL2(), Rollup: rollup.Config{}}
+		err := cfg.Check()
+		require.ErrorIs(t, err, ErrRollupConfig)
+		require.ErrorIs(t, err, rollup.ErrBlockTimeZero)
+	})
+	t.Run("exit after catch-up without cancel", func(t *testing.T) {
+		cfg := &Config{L1: validL1(), L2: validL2(), Rollup: *chaincfg.Sepolia, ExitAfterCatchUp: true}
+		require.EqualError(t, cfg.Check(), "exiting after catch-up requires node cancellation to be available")
+	})
+	t.Run("exit after catch-up when sequencing", func(t *testing.T) {
+		cfg := &Config{L1: validL1(), L2: validL2(), Rollup: *chaincfg.Sepolia, ExitAfterCatchUp: true,
+			Cancel: func(error) {}, Driver: driver.Config{SequencerEnabled: true}}
+		require.EqualError(t, cfg.Check(), "cannot exit after catch-up when sequencing")
+	})
+	t.Run("exit after catch-up", func(t *testing.T) {
+		cfg := &Config{L1: validL1(), L2: validL2(), Rollup: *chaincfg.Sepolia, ExitAfterCatchUp: true,
+			Cancel: func(error) {}}
+		require.NoError(t, cfg.Check())
+	})
+}
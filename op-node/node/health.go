@@ -0,0 +1,112 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// lagHealth tracks the health of the node, based on how far the derivation lags behind the L1 head.
+// Transient lag is debounced: the node only turns unhealthy after lagging continuously for the grace period.
+type lagHealth struct {
+	dr     driverClient
+	maxLag uint64
+	grace  time.Duration
+
+	mu           sync.Mutex
+	laggingSince time.Time // zero if not lagging
+}
+
+func newLagHealth(dr driverClient, maxLag uint64, grace time.Duration) *lagHealth {
+	return &lagHealth{dr: dr, maxLag: maxLag, grace: grace}
+}
+
+// update registers the sync status at the given time, and returns whether the node is healthy,
+// and else the reason why it is not.
+func (h *lagHealth) update(now time.Time, status *eth.SyncStatus) (healthy bool, reason string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if status.HeadL1.Number <= status.CurrentL1.Number+h.maxLag {
+		h.laggingSince = time.Time{}
+		return true, ""
+	}
+	if h.laggingSince.IsZero() {
+		h.laggingSince = now
+	}
+	lagging := now.Sub(h.laggingSince)
+	if lagging < h.grace {
+		return true, ""
+	}
+	return false, fmt.Sprintf("derivation lags %d L1 blocks behind the L1 head, for %s",
+		status.HeadL1.Number-status.CurrentL1.Number, lagging.Round(time.Second))
+}
+
+// check fetches the current sync status and updates the health with it.
+func (h *lagHealth) check(ctx context.Context) (healthy bool, reason string) {
+	status, err := h.dr.SyncStatus(ctx)
+	if err != nil {
+		return false, fmt.Sprintf("failed to get sync status: %v", err)
+	}
+	return h.update(time.Now(), status)
+}
+
+// liveCheckTimeout bounds how long the driver may take to report its sync status, for the node to be considered live.
+const liveCheckTimeout = 5 * time.Second
+
+// liveHandler responds with OK while the driver event loop is responsive, and with a 503 status otherwise.
+// Liveness does not depend on sync progress: a lagging node is live, but not ready.
+func liveHandler(dr driverClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), liveCheckTimeout)
+		defer cancel()
+		if _, err := dr.SyncStatus(ctx); err != nil {
+			http.Error(w, fmt.Sprintf("driver is unresponsive: %v", err), http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("OK"))
+	}
+}
+
+// readyHandler responds with OK while the node is live and, if health is not nil, healthy,
+// and, if l1Age is not nil, the L1 head is fresh.
+// Unlike liveness, readiness failures are expected to be temporary, e.g. while catching up with L1.
+func readyHandler(dr driverClient, health *lagHealth, l1Age *l1HeadAge) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if l1Age != nil {
+			if fresh, reason := l1Age.check(time.Now()); !fresh {
+				http.Error(w, reason, http.StatusServiceUnavailable)
+				return
+			}
+		}
+		ctx, cancel := context.WithTimeout(r.Context(), liveCheckTimeout)
+		defer cancel()
+		if health == nil {
+			if _, err := dr.SyncStatus(ctx); err != nil {
+				http.Error(w, fmt.Sprintf("driver is unresponsive: %v", err), http.StatusServiceUnavailable)
+				return
+			}
+		} else if healthy, reason := health.check(ctx); !healthy {
+			http.Error(w, reason, http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("OK"))
+	}
+}
+
+// healthzHandler responds with the app version. If health is not nil,
+// it responds with a 503 status instead, while the node is unhealthy.
+func healthzHandler(appVersion string, health *lagHealth) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if health != nil {
+			if healthy, reason := health.check(r.Context()); !healthy {
+				http.Error(w, reason, http.StatusServiceUnavailable)
+				return
+			}
+		}
+		_, _ = w.Write([]byte(appVersion))
+	}
+}
@@ -0,0 +1,81 @@
+package node
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+func lagStatus(current, head uint64) *eth.SyncStatus {
+	return &eth.SyncStatus{
+		CurrentL1: eth.L1BlockRef{Number: current},
+		HeadL1:    eth.L1BlockRef{Number: head},
+	}
+}
+
+func TestLagHealth(t *testing.T) {
+	h := newLagHealth(nil, 10, time.Minute)
+	t0 := time.Unix(1000, 0)
+
+	healthy, _ := h.update(t0, lagStatus(100, 110))
+	require.True(t, healthy, "lag within limit")
+
+	// lagging, but within the grace period
+	healthy, _ = h.update(t0, lagStatus(100, 111))
+	require.True(t, healthy)
+	healthy, _ = h.update(t0.Add(59*time.Second), lagStatus(100, 120))
+	require.True(t, healthy)
+
+	// lagging continuously for the full grace period
+	healthy, reason := h.update(t0.Add(time.Minute), lagStatus(100, 120))
+	require.False(t, healthy)
+	require.Contains(t, reason, "lags 20 L1 blocks")
+
+	// catching up makes it healthy immediately, and restarts the grace period
+	healthy, _ = h.update(t0.Add(61*time.Second), lagStatus(115, 120))
+	require.True(t, healthy)
+	healthy, _ = h.update(t0.Add(62*time.Second), lagStatus(115, 130))
+	require.True(t, healthy, "new lag is debounced again")
+	healthy, _ = h.update(t0.Add(122*time.Second), lagStatus(115, 130))
+	require.False(t, healthy)
+}
+
+func TestHealthzHandler(t *testing.T) {
+	get := func(health *lagHealth) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		healthzHandler("v1.2.3", health).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+		return rec
+	}
+
+	rec := get(nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "v1.2.3", rec.Body.String())
+
+	drClient := &mockDriverClient{}
+	drClient.Mock.On("SyncStatus").Return(lagStatus(100, 200))
+	rec = get(newLagHealth(drClient, 10, 0))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.Contains(t, rec.Body.String(), "lags 100 L1 blocks")
+}
+
+func TestLiveAndReadyHandlers(t *testing.T) {
+	get := func(h http.HandlerFunc) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		return rec
+	}
+
+	drClient := &mockDriverClient{}
+	drClient.Mock.On("SyncStatus").Return(lagStatus(100, 200))
+	require.Equal(t, http.StatusOK, get(liveHandler(drClient)).Code, "lagging node is live")
+	require.Equal(t, http.StatusOK, get(readyHandler(drClient, nil, nil)).Code, "ready without lag checks")
+	rec := get(readyHandler(drClient, newLagHealth(drClient, 10, 0), nil))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code, "lagging node is not ready")
+	require.Contains(t, rec.Body.String(), "lags 100 L1 blocks")
+	require.Equal(t, http.StatusOK, get(readyHandler(drClient, newLagHealth(drClient, 100, 0), nil)).Code)
+}
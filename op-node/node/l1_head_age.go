@@ -0,0 +1,79 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// l1HeadAgeMinCheckInterval bounds how often the L1 head age is checked, for short maximum ages.
+const l1HeadAgeMinCheckInterval = time.Second
+
+// l1HeadAge detects a stuck L1 provider: the node is degraded if no L1 head with a newer timestamp
+// has arrived within the maximum age. Heads that repeat or go back in time do not reset the age.
+// The age is measured with the local clock from the arrival of the head, not from its timestamp,
+// to not depend on the clock of the L1 block producers.
+type l1HeadAge struct {
+	log    log.Logger
+	maxAge time.Duration
+
+	mu         sync.Mutex
+	headTime   uint64    // timestamp of the freshest L1 head seen
+	freshSince time.Time // local time at which the freshest L1 head arrived, or at which tracking started
+	degraded   bool
+}
+
+func newL1HeadAge(log log.Logger, maxAge time.Duration, now time.Time) *l1HeadAge {
+	return &l1HeadAge{log: log, maxAge: maxAge, freshSince: now}
+}
+
+// onHead registers the arrival of a L1 head at the given time.
+func (a *l1HeadAge) onHead(now time.Time, head eth.L1BlockRef) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if head.Time <= a.headTime {
+		return
+	}
+	a.headTime = head.Time
+	a.freshSince = now
+	if a.degraded {
+		a.log.Info("L1 head is advancing again", "head", head)
+		a.degraded = false
+	}
+}
+
+// check updates the degraded state at the given time, and returns whether the L1 head is fresh,
+// and else the reason why it is not. The first check that finds the L1 head stale logs an error.
+func (a *l1HeadAge) check(now time.Time) (fresh bool, reason string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	age := now.Sub(a.freshSince)
+	if age <= a.maxAge {
+		return true, ""
+	}
+	reason = fmt.Sprintf("no new L1 head for %s, the L1 provider may be stuck", age.Round(time.Second))
+	if !a.degraded {
+		a.log.Error("L1 head is stale", "age", age, "max_age", a.maxAge, "head_time", a.headTime)
+		a.degraded = true
+	}
+	return false, reason
+}
+
+// monitor periodically checks the L1 head age, to report a stuck L1 provider, until the ctx is canceled.
+func (a *l1HeadAge) monitor(ctx context.Context) {
+	ticker := time.NewTicker(max(a.maxAge/4, l1HeadAgeMinCheckInterval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			a.check(now)
+		}
+	}
+}
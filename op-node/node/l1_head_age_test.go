@@ -0,0 +1,64 @@
+package node
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestL1HeadAgeFrozenL1(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	logs := testlog.Capture(logger)
+	t0 := time.Unix(1000, 0)
+	a := newL1HeadAge(logger, time.Minute, t0)
+
+	fresh, _ := a.check(t0.Add(time.Minute))
+	require.True(t, fresh, "within max age of start")
+
+	a.onHead(t0.Add(30*time.Second), eth.L1BlockRef{Number: 10, Time: 500})
+	fresh, _ = a.check(t0.Add(80 * time.Second))
+	require.True(t, fresh)
+
+	// the provider is frozen: it keeps serving the same head
+	a.onHead(t0.Add(60*time.Second), eth.L1BlockRef{Number: 10, Time: 500})
+	a.onHead(t0.Add(85*time.Second), eth.L1BlockRef{Number: 9, Time: 488})
+	fresh, reason := a.check(t0.Add(91 * time.Second))
+	require.False(t, fresh)
+	require.Contains(t, reason, "no new L1 head for 1m1s")
+	require.NotNil(t, logs.FindLog(log.LvlError, "L1 head is stale"))
+
+	// the error is only logged when the node turns degraded
+	logs.Clear()
+	fresh, _ = a.check(t0.Add(100 * time.Second))
+	require.False(t, fresh)
+	require.Nil(t, logs.FindLog(log.LvlError, "L1 head is stale"))
+
+	// a newer head resets the age
+	a.onHead(t0.Add(110*time.Second), eth.L1BlockRef{Number: 11, Time: 512})
+	require.NotNil(t, logs.FindLog(log.LvlInfo, "L1 head is advancing again"))
+	fresh, _ = a.check(t0.Add(170 * time.Second))
+	require.True(t, fresh)
+}
+
+func TestReadyHandlerL1HeadAge(t *testing.T) {
+	drClient := &mockDriverClient{}
+	drClient.Mock.On("SyncStatus").Return(lagStatus(100, 100))
+	get := func(a *l1HeadAge) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		readyHandler(drClient, nil, a).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+		return rec
+	}
+
+	logger := testlog.Logger(t, log.LvlCrit)
+	require.Equal(t, http.StatusOK, get(newL1HeadAge(logger, time.Minute, time.Now())).Code)
+	rec := get(newL1HeadAge(logger, time.Minute, time.Now().Add(-2*time.Minute)))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.Contains(t, rec.Body.String(), "L1 provider may be stuck")
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	gosync "sync"
 	"sync/atomic"
 	"time"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/heartbeat"
 	"github.com/ethereum-optimism/optimism/op-node/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/p2p"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
 	"github.com/ethereum-optimism/optimism/op-node/version"
@@ -44,17 +46,32 @@ type OpNode struct {
 	l1SafeSub      ethereum.Subscription // Subscription to get L1 safe blocks, a.k.a. justified data (polling)
 	l1FinalizedSub ethereum.Subscription // Subscription to get L1 safe blocks, a.k.a. justified data (polling)
 
-	l1Source  *sources.L1Client     // L1 Client to fetch data from
-	l2Driver  *driver.Driver        // L2 Engine to Sync
-	l2Source  *sources.EngineClient // L2 Execution Engine RPC bindings
-	server    *rpcServer            // RPC server hosting the rollup-node API
-	p2pNode   *p2p.NodeP2P          // P2P node functionality
-	p2pSigner p2p.Signer            // p2p gogssip application messages will be signed with this signer
-	tracer    Tracer                // tracer to get events for testing/debugging
-	runCfg    *RuntimeConfig        // runtime configurables
+	l1Source  *sources.L1Client         // L1 Client to fetch data from
+	l1SlowLog *client.SlowLoggingClient // logs slow L1 requests, with a threshold adjustable at runtime
+	l2Driver  *driver.Driver            // L2 Engine to Sync
+	l2Source  *sources.EngineClient     // L2 Execution Engine RPC bindings
+	server    *rpcServer                // RPC server hosting the rollup-node API
+	p2pNode   *p2p.NodeP2P              // P2P node functionality
+	p2pSigner p2p.Signer                // p2p gogssip application messages will be signed with this signer
+	tracer    Tracer                    // tracer to get events for testing/debugging
+	runCfg    *RuntimeConfig            // runtime configurables
+	rollupCfg *rollup.Config            // rollup config, to analyze the L2 chain against L1
 
 	rollupHalt string // when to halt the rollup, disabled if empty
 
+	exitAfterCatchUp bool // whether to stop the node after derivation caught up with the L1 head at start
+
+	// reloadMu guards the config, against concurrent reloads, and the status logging.
+	reloadMu gosync.Mutex
+	// cfg is a copy of the config the node was created with, updated with the fields that are reloaded.
+	cfg *Config
+	// started indicates the node was started, and background work should run with reloaded settings.
+	started bool
+	// stopStatusLog stops the periodic status logging, nil if not running.
+	stopStatusLog context.CancelFunc
+
+	l1HeadAge *l1HeadAge // detects a stuck L1 provider, nil if disabled
+
 	pprofSrv   *httputil.HTTPServer
 	metricsSrv *httputil.HTTPServer
 
@@ -67,6 +84,8 @@ type OpNode struct {
 	runtimeConfigReloaderDone chan struct{}
 
 	closed atomic.Bool
+	// closeMu serializes Stop calls, so resources are closed at most once, even when stopped concurrently.
+	closeMu gosync.Mutex
 
 	// cancels execution prematurely, e.g. to halt. This may be nil.
 	cancel context.CancelCauseFunc
@@ -84,12 +103,19 @@ func New(ctx context.Context, cfg *Config, log log.Logger, snapshotLog log.Logge
 		return nil, err
 	}
 
+	cfgCopy := *cfg
 	n := &OpNode{
-		log:        log,
-		appVersion: appVersion,
-		metrics:    m,
-		rollupHalt: cfg.RollupHalt,
-		cancel:     cfg.Cancel,
+		log:              log,
+		appVersion:       appVersion,
+		metrics:          m,
+		rollupCfg:        &cfg.Rollup,
+		rollupHalt:       cfg.RollupHalt,
+		cancel:           cfg.Cancel,
+		exitAfterCatchUp: cfg.ExitAfterCatchUp,
+		cfg:              &cfgCopy,
+	}
+	if cfg.MaxL1HeadAge > 0 {
+		n.l1HeadAge = newL1HeadAge(log, cfg.MaxL1HeadAge, time.Now())
 	}
 	// not a context leak, gossipsub is closed with a context.
 	n.resourcesCtx, n.resourcesClose = context.WithCancel(context.Background())
@@ -160,8 +186,9 @@ func (n *OpNode) initL1(ctx context.Context, cfg *Config) error {
 	// Set the RethDB path in the EthClientConfig, if there is one configured.
 	rpcCfg.EthClientConfig.RethDBPath = cfg.RethDBPath
 
+	n.l1SlowLog = client.NewSlowLoggingClient(l1Node, n.log.New("rpc", "l1"), cfg.L1SlowRequestThreshold)
 	n.l1Source, err = sources.NewL1Client(
-		client.NewInstrumentedRPC(l1Node, n.metrics), n.log, n.metrics.L1SourceCache, rpcCfg)
+		client.NewInstrumentedRPC(n.l1SlowLog, n.metrics), n.log, n.metrics.L1SourceCache, rpcCfg)
 	if err != nil {
 		return fmt.Errorf("failed to create L1 source: %w", err)
 	}
@@ -315,11 +342,18 @@ func (n *OpNode) initRPCServer(ctx context.Context, cfg *Config) error {
 	if err != nil {
 		return err
 	}
+	if cfg.MaxL1HeadAgeReadiness {
+		server.l1HeadAge = n.l1HeadAge
+	}
 	if n.p2pNode != nil {
 		server.EnableP2P(p2p.NewP2PAPIBackend(n.p2pNode, n.log, n.metrics))
 	}
 	if cfg.RPC.EnableAdmin {
-		server.EnableAdminAPI(NewAdminAPI(n.l2Driver, n.metrics, n.log))
+		adminAPI := NewAdminAPI(n.l2Driver, n.metrics, n.log)
+		adminAPI.l1SlowLog = n.l1SlowLog
+		adminAPI.l1Head = n
+		adminAPI.ancestor = n
+		server.EnableAdminAPI(adminAPI)
 		n.log.Info("Admin RPC enabled")
 	}
 	n.log.Info("Starting JSON-RPC server")
@@ -417,12 +451,25 @@ func (n *OpNode) Start(ctx context.Context) error {
 		n.log.Error("Could not start a rollup node", "err", err)
 		return err
 	}
+	n.reloadMu.Lock()
+	n.started = true
+	n.restartStatusLog()
+	n.reloadMu.Unlock()
+	if n.exitAfterCatchUp {
+		go cancelAfterCatchUp(n.resourcesCtx, n.log, n.l2Driver, n.cancel, catchUpPollInterval)
+	}
+	if n.l1HeadAge != nil {
+		go n.l1HeadAge.monitor(n.resourcesCtx)
+	}
 	log.Info("Rollup node started")
 	return nil
 }
 
 func (n *OpNode) OnNewL1Head(ctx context.Context, sig eth.L1BlockRef) {
 	n.tracer.OnNewL1Head(ctx, sig)
+	if n.l1HeadAge != nil {
+		n.l1HeadAge.onHead(time.Now(), sig)
+	}
 
 	if n.l2Driver == nil {
 		return
@@ -435,6 +482,39 @@ func (n *OpNode) OnNewL1Head(ctx context.Context, sig eth.L1BlockRef) {
 	}
 }
 
+// RefreshL1Head fetches the latest L1 block, and signals it as new L1 head if it is ahead of the current L1 head.
+// This returns the L1 head of the node after the refresh.
+func (n *OpNode) RefreshL1Head(ctx context.Context) (eth.L1BlockRef, error) {
+	status, err := n.l2Driver.SyncStatus(ctx)
+	if err != nil {
+		return eth.L1BlockRef{}, fmt.Errorf("failed to get current L1 head: %w", err)
+	}
+	latest, err := n.l1Source.L1BlockRefByLabel(ctx, eth.Unsafe)
+	if err != nil {
+		return eth.L1BlockRef{}, fmt.Errorf("failed to fetch latest L1 block: %w", err)
+	}
+	if latest.Number <= status.HeadL1.Number {
+		n.log.Debug("Refreshed L1 head is not ahead of the current L1 head", "latest", latest, "l1_head", status.HeadL1)
+		return status.HeadL1, nil
+	}
+	n.log.Info("Refreshed L1 head", "l1_head", latest, "prev_l1_head", status.HeadL1)
+	n.OnNewL1Head(ctx, latest)
+	return latest, nil
+}
+
+// CommonAncestor walks back from the engine's unsafe head, with the same checks as the sync-start of the driver,
+// and returns the highest L2 block of which the L1 origin is canonical, or not yet known to L1.
+// It errors if the walk-back has to go past the finalized L2 block, or deeper than the reorg limit, to find one.
+func (n *OpNode) CommonAncestor(ctx context.Context) (eth.L2BlockRef, error) {
+	// Skip the sync-start sanity checks below the common ancestor, since only the ancestor is needed.
+	result, err := sync.FindL2Heads(ctx, n.rollupCfg, n.l1Source, n.l2Source, n.log.New("rpc", "common_ancestor"),
+		&sync.Config{SkipSyncStartCheck: true})
+	if err != nil {
+		return eth.L2BlockRef{}, fmt.Errorf("failed to find common ancestor of the engine and L1: %w", err)
+	}
+	return result.Unsafe, nil
+}
+
 func (n *OpNode) OnNewL1Safe(ctx context.Context, sig eth.L1BlockRef) {
 	if n.l2Driver == nil {
 		return
@@ -522,7 +602,10 @@ func (n *OpNode) RuntimeConfig() ReadonlyRuntimeConfig {
 
 // Stop stops the node and closes all resources.
 // If the provided ctx is expired, the node will accelerate the stop where possible, but still fully close.
+// Stop is safe to call concurrently; once the node is fully closed, ErrAlreadyClosed is returned.
 func (n *OpNode) Stop(ctx context.Context) error {
+	n.closeMu.Lock()
+	defer n.closeMu.Unlock()
 	if n.closed.Load() {
 		return ErrAlreadyClosed
 	}
@@ -564,7 +647,7 @@ func (n *OpNode) Stop(ctx context.Context) error {
 
 	// close L2 driver
 	if n.l2Driver != nil {
-		if err := n.l2Driver.Close(); err != nil {
+		if err := n.l2Driver.Close(ctx); err != nil {
 			result = multierror.Append(result, fmt.Errorf("failed to close L2 engine driver cleanly: %w", err))
 		}
 	}
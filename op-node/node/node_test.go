@@ -1,13 +1,47 @@
 package node
 
 import (
+	"context"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
 )
 
 func TestUnixTimeStale(t *testing.T) {
 	require.True(t, unixTimeStale(1_600_000_000, 1*time.Hour))
 	require.False(t, unixTimeStale(uint64(time.Now().Unix()), 1*time.Hour))
 }
+
+func TestStopConcurrently(t *testing.T) {
+	n := &OpNode{log: testlog.Logger(t, log.LvlInfo)}
+
+	const callers = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- n.Stop(context.Background())
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	stopped := 0
+	for err := range errs {
+		if err == nil {
+			stopped++
+		} else {
+			require.ErrorIs(t, err, ErrAlreadyClosed)
+		}
+	}
+	require.Equal(t, 1, stopped, "exactly one Stop call should close the node")
+	require.True(t, n.Stopped())
+}
@@ -0,0 +1,143 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"reflect"
+	"syscall"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
+)
+
+// ErrImmutableConfig is returned when a config reload changes a field that can only be set at start-up.
+var ErrImmutableConfig = errors.New("config field cannot be changed without a restart")
+
+// Reload applies the hot-reloadable fields of the new config to the running node:
+//   - L1SlowRequestThreshold: the L1 slow-request logging threshold.
+//   - SyncProgressLogInterval and StatusLogInterval: the intervals of the status logging, which restarts if changed.
+//
+// The rollup config, the L1 and L2 endpoints, including the L2 Engine JWT secret, and the driver, sync and RPC settings
+// are immutable: if any of these differ from the current config, the reload fails with ErrImmutableConfig,
+// naming the field, and nothing is applied. The sequencer-stopped driver setting is not compared:
+// the admin RPCs change and persist it at runtime, so a freshly loaded config may differ from the start-up config.
+// All other fields are ignored.
+//
+// Some settings can only be changed with a restart:
+//   - The L1 max concurrency (L1EndpointConfig.MaxConcurrency), also known as the receipts fetching concurrency.
+//     It sizes the request limiter that is built into the L1 client when the L1 endpoint is set up.
+//   - Log levels. They are not part of the node config, but are set on the logger at start-up,
+//     and can be changed at runtime with the admin_setLogLevel RPC instead.
+func (n *OpNode) Reload(newCfg *Config) error {
+	n.reloadMu.Lock()
+	defer n.reloadMu.Unlock()
+	oldL2, oldSecret := splitJWTSecret(n.cfg.L2)
+	newL2, newSecret := splitJWTSecret(newCfg.L2)
+	immutable := []struct {
+		name     string
+		old, new any
+	}{
+		{"rollup", n.cfg.Rollup, newCfg.Rollup},
+		{"l1", n.cfg.L1, newCfg.L1},
+		{"l2", oldL2, newL2},
+		{"l2.jwt-secret", oldSecret, newSecret},
+		{"driver", withoutSequencerStopped(n.cfg.Driver), withoutSequencerStopped(newCfg.Driver)},
+		{"sync", n.cfg.Sync, newCfg.Sync},
+		{"rpc", n.cfg.RPC, newCfg.RPC},
+	}
+	for _, f := range immutable {
+		if !reflect.DeepEqual(f.old, f.new) {
+			return fmt.Errorf("%w: %s", ErrImmutableConfig, f.name)
+		}
+	}
+	if newCfg.L1SlowRequestThreshold < 0 {
+		return fmt.Errorf("l1 slow request threshold cannot be negative: %s", newCfg.L1SlowRequestThreshold)
+	}
+	if newCfg.SyncProgressLogInterval < 0 {
+		return fmt.Errorf("sync progress log interval cannot be negative: %s", newCfg.SyncProgressLogInterval)
+	}
+	if newCfg.StatusLogInterval < 0 {
+		return fmt.Errorf("status log interval cannot be negative: %s", newCfg.StatusLogInterval)
+	}
+
+	n.cfg.L1SlowRequestThreshold = newCfg.L1SlowRequestThreshold
+	// compared against the current threshold, since the admin RPC may have changed it since
+	if n.l1SlowLog != nil && newCfg.L1SlowRequestThreshold != n.l1SlowLog.Threshold() {
+		n.l1SlowLog.SetThreshold(newCfg.L1SlowRequestThreshold)
+		n.log.Info("Reloaded L1 slow request threshold", "threshold", newCfg.L1SlowRequestThreshold)
+	}
+	if newCfg.SyncProgressLogInterval != n.cfg.SyncProgressLogInterval || newCfg.StatusLogInterval != n.cfg.StatusLogInterval {
+		n.cfg.SyncProgressLogInterval = newCfg.SyncProgressLogInterval
+		n.cfg.StatusLogInterval = newCfg.StatusLogInterval
+		n.restartStatusLog()
+		n.log.Info("Reloaded status log intervals", "sync_progress", newCfg.SyncProgressLogInterval,
+			"status", newCfg.StatusLogInterval)
+	}
+	return nil
+}
+
+// restartStatusLog (re)starts the periodic status logging with the configured intervals, if the node was started.
+// The reloadMu must be held.
+func (n *OpNode) restartStatusLog() {
+	if n.stopStatusLog != nil {
+		n.stopStatusLog()
+		n.stopStatusLog = nil
+	}
+	if !n.started || (n.cfg.SyncProgressLogInterval <= 0 && n.cfg.StatusLogInterval <= 0) {
+		return
+	}
+	ctx, cancel := context.WithCancel(n.resourcesCtx)
+	n.stopStatusLog = cancel
+	go n.logStatus(ctx, n.cfg.SyncProgressLogInterval, n.cfg.StatusLogInterval)
+}
+
+// splitJWTSecret returns a copy of the L2 endpoint config with the JWT secret cleared, and the secret,
+// if it is an L2EndpointConfig, to report a changed secret separately from the other L2 endpoint settings.
+func splitJWTSecret(l2 L2EndpointSetup) (L2EndpointSetup, [32]byte) {
+	if cfg, ok := l2.(*L2EndpointConfig); ok {
+		out := *cfg
+		out.L2EngineJWTSecret = [32]byte{}
+		return &out, cfg.L2EngineJWTSecret
+	}
+	return l2, [32]byte{}
+}
+
+// withoutSequencerStopped returns the driver config without the sequencer-stopped setting,
+// which is runtime state, persisted by the admin RPCs.
+func withoutSequencerStopped(cfg driver.Config) driver.Config {
+	cfg.SequencerStopped = false
+	return cfg
+}
+
+// ReloadOnSignal reloads the node config on every SIGHUP, until the ctx is canceled.
+// The load function loads a fresh config, e.g. from the CLI flags, environment and config files.
+// Failed loads and reloads are logged, and leave the node config unchanged.
+func ReloadOnSignal(ctx context.Context, n *OpNode, load func() (*Config, error)) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+	defer signal.Stop(sigCh)
+	reloadOn(ctx, n, sigCh, load)
+}
+
+// reloadOn reloads the node config on every signal received on the channel, until the ctx is canceled.
+func reloadOn(ctx context.Context, n *OpNode, sigCh <-chan os.Signal, load func() (*Config, error)) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sigCh:
+			cfg, err := load()
+			if err != nil {
+				n.log.Error("Failed to load config to reload", "err", err)
+				continue
+			}
+			if err := n.Reload(cfg); err != nil {
+				n.log.Error("Failed to reload config", "err", err)
+				continue
+			}
+			n.log.Info("Reloaded config")
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestReload(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	newCfg := func() *Config {
+		return &Config{
+			L1:                     &L1EndpointConfig{L1NodeAddr: "http://127.0.0.1:8545", BatchSize: 20, MaxConcurrency: 10},
+			L2:                     &L2EndpointConfig{L2EngineAddr: "http://127.0.0.1:8551"},
+			L1SlowRequestThreshold: time.Second,
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	n := &OpNode{
+		log:          logger,
+		cfg:          newCfg(),
+		l1SlowLog:    client.NewSlowLoggingClient(nil, logger, time.Second),
+		resourcesCtx: ctx,
+		started:      true,
+	}
+
+	cfg := newCfg()
+	cfg.L1SlowRequestThreshold = 2 * time.Second
+	cfg.StatusLogInterval = time.Minute
+	require.NoError(t, n.Reload(cfg))
+	require.Equal(t, 2*time.Second, n.l1SlowLog.Threshold())
+	require.NotNil(t, n.stopStatusLog, "status logging started")
+
+	cfg = newCfg()
+	cfg.L2 = &L2EndpointConfig{L2EngineAddr: "http://127.0.0.1:9551"}
+	cfg.L1SlowRequestThreshold = 3 * time.Second
+	require.ErrorIs(t, n.Reload(cfg), ErrImmutableConfig)
+	require.Equal(t, 2*time.Second, n.l1SlowLog.Threshold(), "nothing applied")
+
+	cfg = newCfg()
+	cfg.L2 = &L2EndpointConfig{L2EngineAddr: "http://127.0.0.1:8551", L2EngineJWTSecret: [32]byte{1}}
+	cfg.L1SlowRequestThreshold = 3 * time.Second
+	err := n.Reload(cfg)
+	require.ErrorIs(t, err, ErrImmutableConfig)
+	require.ErrorContains(t, err, "l2.jwt-secret")
+	require.Equal(t, 2*time.Second, n.l1SlowLog.Threshold(), "nothing applied")
+
+	cfg = newCfg()
+	cfg.L1 = &L1EndpointConfig{L1NodeAddr: "http://127.0.0.1:8545", BatchSize: 20, MaxConcurrency: 20}
+	require.ErrorIs(t, n.Reload(cfg), ErrImmutableConfig, "the L1 max concurrency needs a restart")
+
+	cfg = newCfg()
+	cfg.Driver.SequencerStopped = true
+	cfg.L1SlowRequestThreshold = 3 * time.Second
+	cfg.StatusLogInterval = time.Minute
+	require.NoError(t, n.Reload(cfg), "the persisted sequencer state is not compared")
+	require.Equal(t, 3*time.Second, n.l1SlowLog.Threshold())
+
+	cfg = newCfg()
+	cfg.StatusLogInterval = -time.Second
+	require.ErrorContains(t, n.Reload(cfg), "cannot be negative")
+
+	require.NoError(t, n.Reload(newCfg()))
+	require.Equal(t, time.Second, n.l1SlowLog.Threshold())
+	require.Nil(t, n.stopStatusLog, "status logging disabled")
+}
+
+func TestReloadOn(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	logs := testlog.Capture(logger)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	n := &OpNode{
+		log:          logger,
+		cfg:          &Config{L1: &L1EndpointConfig{L1NodeAddr: "http://127.0.0.1:8545"}, L2: &L2EndpointConfig{}},
+		l1SlowLog:    client.NewSlowLoggingClient(nil, logger, time.Second),
+		resourcesCtx: ctx,
+	}
+
+	sigCh := make(chan os.Signal)
+	loads := make(chan *Config)
+	done := make(chan struct{})
+	go func() {
+		reloadOn(ctx, n, sigCh, func() (*Config, error) {
+			cfg := <-loads
+			if cfg == nil {
+				return nil, errors.New("bad config file")
+			}
+			return cfg, nil
+		})
+		close(done)
+	}()
+
+	sigCh <- syscall.SIGHUP
+	loads <- nil
+	sigCh <- syscall.SIGHUP
+	loads <- &Config{L1: n.cfg.L1, L2: n.cfg.L2, L1SlowRequestThreshold: 2 * time.Second}
+	cancel()
+	<-done
+	require.NotNil(t, logs.FindLog(log.LvlError, "Failed to load config to reload"))
+	require.NotNil(t, logs.FindLog(log.LvlInfo, "Reloaded config"))
+	require.Equal(t, 2*time.Second, n.l1SlowLog.Threshold())
+}
@@ -23,6 +23,9 @@ type rpcServer struct {
 	apis       []rpc.API
 	httpServer *ophttp.HTTPServer
 	appVersion string
+	dr         driverClient
+	health     *lagHealth // nil if health checks are disabled
+	l1HeadAge  *l1HeadAge // nil if the L1 head age does not affect readiness
 	log        log.Logger
 	sources.L2Client
 }
@@ -39,8 +42,12 @@ func newRPCServer(ctx context.Context, rpcCfg *RPCConfig, rollupCfg *rollup.Conf
 			Authenticated: false,
 		}},
 		appVersion: appVersion,
+		dr:         dr,
 		log:        log,
 	}
+	if rpcCfg.HealthyLagBlocks > 0 {
+		r.health = newLagHealth(dr, rpcCfg.HealthyLagBlocks, rpcCfg.HealthyLagGrace)
+	}
 	return r, nil
 }
 
@@ -76,7 +83,9 @@ func (s *rpcServer) Start() error {
 
 	mux := http.NewServeMux()
 	mux.Handle("/", nodeHandler)
-	mux.HandleFunc("/healthz", healthzHandler(s.appVersion))
+	mux.HandleFunc("/healthz", healthzHandler(s.appVersion, s.health))
+	mux.HandleFunc("/live", liveHandler(s.dr))
+	mux.HandleFunc("/ready", readyHandler(s.dr, s.health, s.l1HeadAge))
 
 	hs, err := ophttp.StartHTTPServer(s.endpoint, mux)
 	if err != nil {
@@ -93,9 +102,3 @@ func (r *rpcServer) Stop(ctx context.Context) error {
 func (r *rpcServer) Addr() net.Addr {
 	return r.httpServer.Addr()
 }
-
-func healthzHandler(appVersion string) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		_, _ = w.Write([]byte(appVersion))
-	}
-}
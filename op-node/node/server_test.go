@@ -230,3 +230,11 @@ func (c *mockDriverClient) StopSequencer(ctx context.Context) (common.Hash, erro
 func (c *mockDriverClient) SequencerActive(ctx context.Context) (bool, error) {
 	return c.Mock.MethodCalled("SequencerActive").Get(0).(bool), nil
 }
+
+func (c *mockDriverClient) PauseDerivation(ctx context.Context) error {
+	return c.Mock.MethodCalled("PauseDerivation").Get(0).(error)
+}
+
+func (c *mockDriverClient) ResumeDerivation(ctx context.Context) error {
+	return c.Mock.MethodCalled("ResumeDerivation").Get(0).(error)
+}
@@ -0,0 +1,159 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+const (
+	// syncProgressMinLag is the number of L1 blocks the derivation has to lag behind the L1 head,
+	// to be considered catching up and have its progress logged.
+	syncProgressMinLag = 32
+	// catchUpPollInterval is the interval at which the derivation progress is checked, to exit after catching up.
+	catchUpPollInterval = 2 * time.Second
+)
+
+var errCaughtUp = errors.New("caught up with L1 head")
+
+// syncProgress estimates the derivation progress towards the L1 head.
+// The ETA is based on the net catch-up rate between updates:
+// the rate of derived L1 blocks, minus the rate at which the L1 head itself advances.
+type syncProgress struct {
+	start uint64 // L1 block number that derivation was at, when progress tracking started
+
+	prevTime    time.Time
+	prevCurrent uint64
+	prevHead    uint64
+}
+
+// update registers the derivation origin and L1 head at the given time, and returns the percentage
+// of the L1 chain that has been derived since the first update, and the estimated time to reach the L1 head.
+// The ETA is only known (ok == true) from the second update on, and while derivation is gaining on the L1 head.
+func (p *syncProgress) update(now time.Time, current, head uint64) (pct float64, eta time.Duration, ok bool) {
+	if p.prevTime.IsZero() || current < p.start {
+		p.start = current
+	}
+	pct = 100
+	if head > p.start && head > current {
+		pct = 100 * float64(current-p.start) / float64(head-p.start)
+	}
+	if !p.prevTime.IsZero() && now.After(p.prevTime) && head > current {
+		dt := now.Sub(p.prevTime).Seconds()
+		derivedRate := (float64(current) - float64(p.prevCurrent)) / dt
+		headRate := (float64(head) - float64(p.prevHead)) / dt
+		if netRate := derivedRate - headRate; netRate > 0 {
+			eta = time.Duration(float64(head-current) / netRate * float64(time.Second))
+			ok = true
+		}
+	}
+	p.prevTime, p.prevCurrent, p.prevHead = now, current, head
+	return pct, eta, ok
+}
+
+// logStatus logs the node status, until the ctx is canceled:
+//   - every progressInterval, while the node is catching up with L1, the derivation progress and ETA;
+//   - every statusInterval, the L1 head, the derivation origin and its lag, and the unsafe, safe and finalized L2 heads.
+//
+// A zero interval disables the respective logging. The status line has no L1 downloader queue depth or active
+// L1 source: the derivation pipeline fetches L1 data on demand, without a queue, from the single L1 endpoint.
+func (n *OpNode) logStatus(ctx context.Context, progressInterval, statusInterval time.Duration) {
+	var progressTick, statusTick <-chan time.Time
+	if progressInterval > 0 {
+		ticker := time.NewTicker(progressInterval)
+		defer ticker.Stop()
+		progressTick = ticker.C
+	}
+	if statusInterval > 0 {
+		ticker := time.NewTicker(statusInterval)
+		defer ticker.Stop()
+		statusTick = ticker.C
+	}
+	var progress syncProgress
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-progressTick:
+			if status, ok := n.statusToLog(ctx, progressInterval); ok {
+				n.logSyncProgress(&progress, now, status)
+			}
+		case <-statusTick:
+			if status, ok := n.statusToLog(ctx, statusInterval); ok {
+				var lag uint64
+				if status.HeadL1.Number > status.CurrentL1.Number {
+					lag = status.HeadL1.Number - status.CurrentL1.Number
+				}
+				n.log.Info("Node status", "head_l1", status.HeadL1, "current_l1", status.CurrentL1, "l1_lag", lag,
+					"unsafe_l2", status.UnsafeL2, "safe_l2", status.SafeL2, "finalized_l2", status.FinalizedL2)
+			}
+		}
+	}
+}
+
+// statusToLog fetches the sync status to log, within the given timeout. Failures are only logged at debug level.
+func (n *OpNode) statusToLog(ctx context.Context, timeout time.Duration) (*eth.SyncStatus, bool) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	status, err := n.l2Driver.SyncStatus(ctx)
+	if err != nil {
+		n.log.Debug("Failed to get sync status to log", "err", err)
+		return nil, false
+	}
+	return status, true
+}
+
+// logSyncProgress updates the derivation progress with the status, and logs it if the node is catching up with L1.
+func (n *OpNode) logSyncProgress(progress *syncProgress, now time.Time, status *eth.SyncStatus) {
+	pct, eta, ok := progress.update(now, status.CurrentL1.Number, status.HeadL1.Number)
+	if status.HeadL1.Number < status.CurrentL1.Number+syncProgressMinLag {
+		return
+	}
+	etaStr := "unknown"
+	if ok {
+		etaStr = eta.Round(time.Second).String()
+	}
+	n.log.Info("Derivation is catching up with L1", "current_l1", status.CurrentL1, "head_l1", status.HeadL1,
+		"progress", fmt.Sprintf("%.2f%%", pct), "eta", etaStr)
+}
+
+// cancelAfterCatchUp calls cancel, with errCaughtUp, once the derivation origin has reached the L1 head,
+// as seen on the first check. The target is fixed on purpose, for the node to not keep chasing an advancing L1 head.
+// Note that with a verifier confirmation depth, the target is only reached once the L1 head is that many blocks further.
+func cancelAfterCatchUp(ctx context.Context, log log.Logger, dr driverClient, cancel context.CancelCauseFunc, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var target eth.L1BlockRef
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			statusCtx, statusCancel := context.WithTimeout(ctx, interval)
+			status, err := dr.SyncStatus(statusCtx)
+			statusCancel()
+			if err != nil {
+				log.Debug("Failed to get sync status to check catch-up", "err", err)
+				continue
+			}
+			if target == (eth.L1BlockRef{}) {
+				if status.HeadL1 == (eth.L1BlockRef{}) {
+					continue // no L1 head known yet
+				}
+				target = status.HeadL1
+				log.Info("Catching up with L1, the node will exit once the target is reached", "target", target)
+			}
+			if status.CurrentL1.Number >= target.Number {
+				log.Info("Caught up with L1, exiting", "target", target, "current_l1", status.CurrentL1,
+					"safe_l2", status.SafeL2, "unsafe_l2", status.UnsafeL2)
+				cancel(errCaughtUp)
+				return
+			}
+		}
+	}
+}
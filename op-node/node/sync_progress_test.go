@@ -0,0 +1,56 @@
+package node
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestSyncProgress(t *testing.T) {
+	var p syncProgress
+	t0 := time.Unix(1000, 0)
+
+	pct, _, ok := p.update(t0, 100, 1100)
+	require.False(t, ok, "no rate known yet")
+	require.Equal(t, 0.0, pct)
+
+	// derived 500 blocks in 100s, while L1 advanced 100 blocks: net 4 blocks/s, 600 blocks to go.
+	pct, eta, ok := p.update(t0.Add(100*time.Second), 600, 1100+100)
+	require.True(t, ok)
+	require.InDelta(t, 100*500.0/1100.0, pct, 0.001)
+	require.Equal(t, 150*time.Second, eta)
+
+	// not gaining on the L1 head: ETA is unknown
+	_, _, ok = p.update(t0.Add(200*time.Second), 610, 1250)
+	require.False(t, ok)
+
+	// caught up
+	pct, _, ok = p.update(t0.Add(300*time.Second), 1250, 1250)
+	require.False(t, ok)
+	require.Equal(t, 100.0, pct)
+
+	// derivation reset to an older origin restarts the progress measurement
+	pct, _, _ = p.update(t0.Add(400*time.Second), 50, 1260)
+	require.Equal(t, 0.0, pct)
+}
+
+func TestCancelAfterCatchUp(t *testing.T) {
+	drClient := &mockDriverClient{}
+	drClient.Mock.On("SyncStatus").Return(&eth.SyncStatus{}).Once() // L1 head not known yet
+	drClient.Mock.On("SyncStatus").Return(lagStatus(50, 100)).Once()
+	drClient.Mock.On("SyncStatus").Return(lagStatus(90, 120)).Once()
+	// the target stays at the first L1 head seen, even though the L1 head advanced
+	drClient.Mock.On("SyncStatus").Return(lagStatus(100, 130)).Once()
+
+	var causes []error
+	cancel := func(cause error) { causes = append(causes, cause) }
+	cancelAfterCatchUp(context.Background(), testlog.Logger(t, log.LvlInfo), drClient, cancel, time.Millisecond)
+	require.Equal(t, []error{errCaughtUp}, causes, "canceled exactly once, after reaching the target")
+	drClient.Mock.AssertNumberOfCalls(t, "SyncStatus", 4)
+}
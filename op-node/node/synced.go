@@ -0,0 +1,40 @@
+package node
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// waitSyncedPollInterval is the interval at which the sync status is checked, while waiting for the node to be synced.
+const waitSyncedPollInterval = 2 * time.Second
+
+// WaitSynced blocks until the derivation lags at most maxLag L1 blocks behind the L1 head, as reported by the
+// optimism_syncStatus RPC, or until the ctx is canceled. It returns immediately if the node is already synced.
+// A maxLag of 0 requires derivation to be exactly at the L1 head, which a new L1 block undoes again,
+// so callers should allow a few blocks, e.g. the verifier confirmation depth plus a small margin.
+// Before the first L1 head is known, the node is never considered synced.
+func (n *OpNode) WaitSynced(ctx context.Context, maxLag uint64) error {
+	return waitSynced(ctx, n.l2Driver, maxLag, waitSyncedPollInterval)
+}
+
+func waitSynced(ctx context.Context, dr driverClient, maxLag uint64, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		status, err := dr.SyncStatus(ctx)
+		if err != nil && ctx.Err() != nil {
+			return ctx.Err()
+		}
+		// the L1 head is unknown until the first L1 head signal
+		if err == nil && status.HeadL1 != (eth.L1BlockRef{}) && status.HeadL1.Number <= status.CurrentL1.Number+maxLag {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
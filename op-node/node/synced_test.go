@@ -0,0 +1,36 @@
+package node
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+func TestWaitSynced(t *testing.T) {
+	status := func(current, head uint64) *eth.SyncStatus {
+		return &eth.SyncStatus{CurrentL1: eth.L1BlockRef{Number: current}, HeadL1: eth.L1BlockRef{Number: head}}
+	}
+	ctx := context.Background()
+
+	drClient := &mockDriverClient{}
+	drClient.Mock.On("SyncStatus").Return(status(95, 100))
+	require.NoError(t, waitSynced(ctx, drClient, 10, time.Hour), "returns immediately when within the lag")
+	drClient.Mock.AssertNumberOfCalls(t, "SyncStatus", 1)
+
+	drClient = &mockDriverClient{}
+	drClient.Mock.On("SyncStatus").Return(&eth.SyncStatus{}).Once() // L1 head not known yet
+	drClient.Mock.On("SyncStatus").Return(status(10, 100)).Once()
+	drClient.Mock.On("SyncStatus").Return(status(100, 100)).Once()
+	require.NoError(t, waitSynced(ctx, drClient, 0, time.Millisecond))
+	drClient.Mock.AssertNumberOfCalls(t, "SyncStatus", 3)
+
+	drClient = &mockDriverClient{}
+	drClient.Mock.On("SyncStatus").Return(status(10, 100))
+	ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, waitSynced(ctx, drClient, 10, time.Millisecond), context.DeadlineExceeded)
+}
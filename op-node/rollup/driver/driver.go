@@ -37,6 +37,7 @@ type Metrics interface {
 
 	EngineMetrics
 	L1FetcherMetrics
+	L2ChainMetrics
 	SequencerMetrics
 }
 
@@ -119,6 +120,7 @@ type SequencerStateListener interface {
 // NewDriver composes an events handler that tracks L1 state, triggers L2 derivation, and optionally sequences new L2 blocks.
 func NewDriver(driverCfg *Config, cfg *rollup.Config, l2 L2Chain, l1 L1Chain, altSync AltSync, network Network, log log.Logger, snapshotLog log.Logger, metrics Metrics, sequencerStateListener SequencerStateListener, syncCfg *sync.Config) *Driver {
 	l1 = NewMeteredL1Fetcher(l1, metrics)
+	l2 = NewMeteredL2Chain(l2, metrics, log)
 	l1State := NewL1State(log, metrics)
 	sequencerConfDepth := NewConfDepth(driverCfg.SequencerConfDepth, l1State.L1Head, l1)
 	findL1Origin := NewL1OriginSelector(log, cfg, sequencerConfDepth)
@@ -129,6 +131,7 @@ func NewDriver(driverCfg *Config, cfg *rollup.Config, l2 L2Chain, l1 L1Chain, al
 	meteredEngine := NewMeteredEngine(cfg, engine, metrics, log)
 	sequencer := NewSequencer(log, cfg, meteredEngine, attrBuilder, findL1Origin, metrics)
 	driverCtx, driverCancel := context.WithCancel(context.Background())
+	loopCtx, loopCancel := context.WithCancel(driverCtx)
 	return &Driver{
 		l1State:          l1State,
 		derivation:       derivationPipeline,
@@ -137,11 +140,15 @@ func NewDriver(driverCfg *Config, cfg *rollup.Config, l2 L2Chain, l1 L1Chain, al
 		startSequencer:   make(chan hashAndErrorChannel, 10),
 		stopSequencer:    make(chan chan hashAndError, 10),
 		sequencerActive:  make(chan chan bool, 10),
+		pauseDerivation:  make(chan chan error, 10),
+		resumeDerivation: make(chan chan error, 10),
 		sequencerNotifs:  sequencerStateListener,
 		config:           cfg,
 		driverConfig:     driverCfg,
 		driverCtx:        driverCtx,
 		driverCancel:     driverCancel,
+		loopCtx:          loopCtx,
+		loopCancel:       loopCancel,
 		log:              log,
 		snapshotLog:      snapshotLog,
 		l1:               l1,
@@ -0,0 +1,57 @@
+package driver
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+type L2ChainMetrics interface {
+	RecordForkchoiceUpdate(status string, duration time.Duration)
+}
+
+// forkchoiceErrStatus is the status recorded for forkchoice updates that failed without a payload status.
+const forkchoiceErrStatus = "error"
+
+// MeteredL2Chain wraps a L2Chain, to record the payload status and duration of every forkchoice update,
+// and to log changes of the forkchoice status, e.g. when the engine starts or stops syncing.
+// It is not safe for concurrent use, like the driver event loop that uses it.
+type MeteredL2Chain struct {
+	L2Chain
+	metrics L2ChainMetrics
+	log     log.Logger
+	now     func() time.Time
+
+	lastStatus string
+}
+
+func NewMeteredL2Chain(inner L2Chain, metrics L2ChainMetrics, log log.Logger) *MeteredL2Chain {
+	return &MeteredL2Chain{
+		L2Chain: inner,
+		metrics: metrics,
+		log:     log,
+		now:     time.Now,
+	}
+}
+
+func (m *MeteredL2Chain) ForkchoiceUpdate(ctx context.Context, state *eth.ForkchoiceState, attr *eth.PayloadAttributes) (*eth.ForkchoiceUpdatedResult, error) {
+	start := m.now()
+	res, err := m.L2Chain.ForkchoiceUpdate(ctx, state, attr)
+	status := forkchoiceErrStatus
+	if err == nil {
+		status = string(res.PayloadStatus.Status)
+	}
+	m.metrics.RecordForkchoiceUpdate(status, m.now().Sub(start))
+	if status != m.lastStatus {
+		if m.lastStatus != "" {
+			m.log.Info("Engine forkchoice status changed", "prev", m.lastStatus, "status", status, "head", state.HeadBlockHash)
+		}
+		m.lastStatus = status
+	}
+	return res, err
+}
+
+var _ L2Chain = (*MeteredL2Chain)(nil)
@@ -0,0 +1,79 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+type recordedForkchoiceUpdate struct {
+	status   string
+	duration time.Duration
+}
+
+type fakeL2ChainMetrics struct {
+	updates []recordedForkchoiceUpdate
+}
+
+func (m *fakeL2ChainMetrics) RecordForkchoiceUpdate(status string, duration time.Duration) {
+	m.updates = append(m.updates, recordedForkchoiceUpdate{status: status, duration: duration})
+}
+
+func TestMeteredL2ChainForkchoiceUpdate(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	logs := testlog.Capture(logger)
+	inner := &testutils.MockEngine{}
+	metrics := &fakeL2ChainMetrics{}
+	chain := NewMeteredL2Chain(inner, metrics, logger)
+	currTime := time.Unix(1000, 0)
+	chain.now = func() time.Time {
+		currTime = currTime.Add(10 * time.Millisecond)
+		return currTime
+	}
+	state := &eth.ForkchoiceState{}
+	result := func(status eth.ExecutePayloadStatus) *eth.ForkchoiceUpdatedResult {
+		return &eth.ForkchoiceUpdatedResult{PayloadStatus: eth.PayloadStatusV1{Status: status}}
+	}
+	update := func(res *eth.ForkchoiceUpdatedResult, err error) {
+		inner.ExpectForkchoiceUpdate(state, nil, res, err)
+		actual, actualErr := chain.ForkchoiceUpdate(context.Background(), state, nil)
+		require.Equal(t, res, actual)
+		require.ErrorIs(t, actualErr, err)
+	}
+
+	update(result(eth.ExecutionSyncing), nil)
+	require.Nil(t, logs.FindLog(log.LvlInfo, "Engine forkchoice status changed"), "no change on first update")
+	update(result(eth.ExecutionSyncing), nil)
+	require.Nil(t, logs.FindLog(log.LvlInfo, "Engine forkchoice status changed"))
+
+	update(result(eth.ExecutionValid), nil)
+	l := logs.FindLog(log.LvlInfo, "Engine forkchoice status changed")
+	require.NotNil(t, l)
+	require.Equal(t, string(eth.ExecutionSyncing), l.GetContextValue("prev"))
+	require.Equal(t, string(eth.ExecutionValid), l.GetContextValue("status"))
+
+	logs.Clear()
+	update(nil, errors.New("test error"))
+	l = logs.FindLog(log.LvlInfo, "Engine forkchoice status changed")
+	require.NotNil(t, l)
+	require.Equal(t, forkchoiceErrStatus, l.GetContextValue("status"))
+
+	update(result(eth.ExecutionInvalid), nil)
+
+	require.Equal(t, []recordedForkchoiceUpdate{
+		{string(eth.ExecutionSyncing), 10 * time.Millisecond},
+		{string(eth.ExecutionSyncing), 10 * time.Millisecond},
+		{string(eth.ExecutionValid), 10 * time.Millisecond},
+		{forkchoiceErrStatus, 10 * time.Millisecond},
+		{string(eth.ExecutionInvalid), 10 * time.Millisecond},
+	}, metrics.updates)
+	inner.AssertExpectations(t)
+}
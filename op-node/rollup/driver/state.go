@@ -52,6 +52,18 @@ type Driver struct {
 	// true when the sequencer is active, false when it is not.
 	sequencerActive chan chan bool
 
+	// Upon receiving a channel in this channel, derivation is paused.
+	// It tells the caller that derivation paused by closing the passed in channel (or returning an error).
+	pauseDerivation chan chan error
+
+	// Upon receiving a channel in this channel, derivation is resumed.
+	// It tells the caller that derivation resumed by closing the passed in channel (or returning an error).
+	resumeDerivation chan chan error
+
+	// derivationPaused is only accessed by the event loop, or while the event loop is blocked.
+	// L1 signals and unsafe payloads are still tracked while paused, but no derivation steps are taken.
+	derivationPaused bool
+
 	// sequencerNotifs is notified when the sequencer is started or stopped
 	sequencerNotifs SequencerStateListener
 
@@ -91,6 +103,11 @@ type Driver struct {
 
 	driverCtx    context.Context
 	driverCancel context.CancelFunc
+
+	// loopCtx is derived from driverCtx, and is canceled to stop the event loop from picking up new work,
+	// while leaving the driverCtx of in-flight work untouched.
+	loopCtx    context.Context
+	loopCancel context.CancelFunc
 }
 
 // Start starts up the state loop.
@@ -119,9 +136,27 @@ func (s *Driver) Start() error {
 	return nil
 }
 
-func (s *Driver) Close() error {
+// Close stops the event loop, and waits for it to exit.
+// In-flight work, like a derivation step or a sequencer action, is drained first,
+// to not interrupt the engine halfway through applying a block.
+// If the provided ctx expires before the in-flight work completes, it is canceled to shut down faster,
+// and an error is returned.
+func (s *Driver) Close(ctx context.Context) error {
+	s.loopCancel()
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		s.log.Warn("Shutdown context expired before driver was drained, canceling in-flight work", "err", ctx.Err())
+		s.driverCancel()
+		<-done
+		return fmt.Errorf("driver did not drain in time: %w", ctx.Err())
+	}
 	s.driverCancel()
-	s.wg.Wait()
 	return nil
 }
 
@@ -232,7 +267,7 @@ func (s *Driver) eventLoop() {
 	lastUnsafeL2 := s.derivation.UnsafeL2Head()
 
 	for {
-		if s.driverCtx.Err() != nil { // don't try to schedule/handle more work when we are closing.
+		if s.loopCtx.Err() != nil { // don't try to schedule/handle more work when we are closing.
 			return
 		}
 
@@ -315,6 +350,10 @@ func (s *Driver) eventLoop() {
 			delayedStepReq = nil
 			step()
 		case <-stepReqCh:
+			if s.derivationPaused {
+				// Drop the request, derivation catches up with a new step request when it is resumed.
+				continue
+			}
 			s.metrics.SetDerivationIdle(false)
 			s.log.Debug("Derivation process step", "onto_origin", s.derivation.Origin(), "attempts", stepAttempts)
 			err := s.derivation.Step(s.driverCtx)
@@ -365,6 +404,9 @@ func (s *Driver) eventLoop() {
 			unsafeHead := s.derivation.UnsafeL2Head().Hash
 			if !s.driverConfig.SequencerStopped {
 				resp.err <- errors.New("sequencer already running")
+			} else if s.derivationPaused {
+				// Like pausing while sequencing, sequencing while paused would build on a frozen safe head.
+				resp.err <- errors.New("derivation must be resumed before starting the sequencer")
 			} else if !bytes.Equal(unsafeHead[:], resp.hash[:]) {
 				resp.err <- fmt.Errorf("block hash does not match: head %s, received %s", unsafeHead.String(), resp.hash.String())
 			} else {
@@ -394,7 +436,27 @@ func (s *Driver) eventLoop() {
 			}
 		case respCh := <-s.sequencerActive:
 			respCh <- !s.driverConfig.SequencerStopped
-		case <-s.driverCtx.Done():
+		case respCh := <-s.pauseDerivation:
+			if s.derivationPaused {
+				respCh <- errors.New("derivation already paused")
+			} else if s.driverConfig.SequencerEnabled && !s.driverConfig.SequencerStopped {
+				respCh <- errors.New("sequencer must be stopped before pausing derivation")
+			} else {
+				s.log.Warn("Derivation has been paused")
+				s.derivationPaused = true
+				s.metrics.SetDerivationIdle(true)
+				close(respCh)
+			}
+		case respCh := <-s.resumeDerivation:
+			if !s.derivationPaused {
+				respCh <- errors.New("derivation not paused")
+			} else {
+				s.log.Info("Derivation has been resumed")
+				s.derivationPaused = false
+				close(respCh)
+				reqStep() // catch up with the L1 and unsafe L2 data received while paused
+			}
+		case <-s.loopCtx.Done():
 			return
 		}
 	}
@@ -475,6 +537,32 @@ func (s *Driver) SequencerActive(ctx context.Context) (bool, error) {
 	}
 }
 
+// PauseDerivation pauses the derivation process, without affecting the L1 and L2 connections.
+// The sequencer has to be stopped first, if it is enabled.
+func (s *Driver) PauseDerivation(ctx context.Context) error {
+	return s.sendToLoop(ctx, s.pauseDerivation)
+}
+
+// ResumeDerivation resumes the derivation process after PauseDerivation.
+func (s *Driver) ResumeDerivation(ctx context.Context) error {
+	return s.sendToLoop(ctx, s.resumeDerivation)
+}
+
+func (s *Driver) sendToLoop(ctx context.Context, reqCh chan chan error) error {
+	respCh := make(chan error, 1)
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case reqCh <- respCh:
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-respCh:
+			return err
+		}
+	}
+}
+
 // syncStatus returns the current sync status, and should only be called synchronously with
 // the driver event loop to avoid retrieval of an inconsistent status.
 func (s *Driver) syncStatus() *eth.SyncStatus {
@@ -490,6 +578,7 @@ func (s *Driver) syncStatus() *eth.SyncStatus {
 		PendingSafeL2:      s.derivation.PendingSafeL2Head(),
 		UnsafeL2SyncTarget: s.derivation.UnsafeL2SyncTarget(),
 		EngineSyncTarget:   s.derivation.EngineSyncTarget(),
+		DerivationPaused:   s.derivationPaused,
 	}
 }
 
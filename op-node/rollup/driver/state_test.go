@@ -0,0 +1,191 @@
+package driver
+
+import (
+	"context"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+// newClosableDriver creates a driver with only the state needed to close it,
+// and an in-flight task that takes until release is closed, or until the task is canceled.
+func newClosableDriver(t *testing.T) (d *Driver, release chan struct{}, canceled chan struct{}) {
+	driverCtx, driverCancel := context.WithCancel(context.Background())
+	loopCtx, loopCancel := context.WithCancel(driverCtx)
+	d = &Driver{
+		log:          testlog.Logger(t, log.LvlInfo),
+		driverCtx:    driverCtx,
+		driverCancel: driverCancel,
+		loopCtx:      loopCtx,
+		loopCancel:   loopCancel,
+	}
+	release = make(chan struct{})
+	canceled = make(chan struct{})
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		<-d.loopCtx.Done() // the loop stops picking up new work first
+		select {
+		case <-release:
+		case <-d.driverCtx.Done():
+			close(canceled)
+		}
+	}()
+	return d, release, canceled
+}
+
+func TestDriverCloseDrains(t *testing.T) {
+	d, release, canceled := newClosableDriver(t)
+	closed := make(chan error, 1)
+	go func() {
+		closed <- d.Close(context.Background())
+	}()
+	close(release)
+	require.NoError(t, <-closed)
+	select {
+	case <-canceled:
+		t.Fatal("in-flight work should not be canceled when it drains in time")
+	default:
+	}
+}
+
+func TestDriverCloseTimeout(t *testing.T) {
+	d, _, canceled := newClosableDriver(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := d.Close(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	require.ErrorContains(t, err, "driver did not drain in time")
+	<-canceled
+}
+
+// fakeDerivation is a derivation pipeline that counts its steps, and is always idle.
+type fakeDerivation struct {
+	DerivationPipeline
+	steps atomic.Int64
+}
+
+func (f *fakeDerivation) Reset() {}
+
+func (f *fakeDerivation) Step(ctx context.Context) error {
+	f.steps.Add(1)
+	return io.EOF
+}
+
+func (f *fakeDerivation) UnsafeL2Head() eth.L2BlockRef       { return eth.L2BlockRef{} }
+func (f *fakeDerivation) SafeL2Head() eth.L2BlockRef         { return eth.L2BlockRef{} }
+func (f *fakeDerivation) PendingSafeL2Head() eth.L2BlockRef  { return eth.L2BlockRef{} }
+func (f *fakeDerivation) Finalized() eth.L2BlockRef          { return eth.L2BlockRef{} }
+func (f *fakeDerivation) FinalizedL1() eth.L1BlockRef        { return eth.L1BlockRef{} }
+func (f *fakeDerivation) Origin() eth.L1BlockRef             { return eth.L1BlockRef{} }
+func (f *fakeDerivation) UnsafeL2SyncTarget() eth.L2BlockRef { return eth.L2BlockRef{} }
+func (f *fakeDerivation) EngineSyncTarget() eth.L2BlockRef   { return eth.L2BlockRef{} }
+func (f *fakeDerivation) EngineReady() bool                  { return true }
+
+// fakeDriverMetrics implements the metrics used by the driver event loop, while idle.
+type fakeDriverMetrics struct {
+	Metrics
+}
+
+func (m *fakeDriverMetrics) SetDerivationIdle(idle bool)                 {}
+func (m *fakeDriverMetrics) RecordL1Ref(name string, ref eth.L1BlockRef) {}
+func (m *fakeDriverMetrics) RecordL1ReorgDepth(d uint64)                 {}
+
+// fakeSequencer is a sequencer that never plans to take an action soon.
+type fakeSequencer struct {
+	SequencerIface
+}
+
+func (f *fakeSequencer) PlanNextSequencerAction() time.Duration { return time.Hour }
+
+type fakeSequencerStateListener struct{}
+
+func (l *fakeSequencerStateListener) SequencerStarted() error { return nil }
+func (l *fakeSequencerStateListener) SequencerStopped() error { return nil }
+
+// startTestDriver starts a driver event loop with a fake derivation pipeline,
+// and an enabled but stopped sequencer, and waits for the initial derivation step.
+func startTestDriver(t *testing.T) (*Driver, *fakeDerivation) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	derivation := &fakeDerivation{}
+	metrics := &fakeDriverMetrics{}
+	driverCtx, driverCancel := context.WithCancel(context.Background())
+	loopCtx, loopCancel := context.WithCancel(driverCtx)
+	d := &Driver{
+		l1State:          NewL1State(logger, metrics),
+		derivation:       derivation,
+		stateReq:         make(chan chan struct{}),
+		forceReset:       make(chan chan struct{}, 10),
+		startSequencer:   make(chan hashAndErrorChannel, 10),
+		stopSequencer:    make(chan chan hashAndError, 10),
+		sequencerActive:  make(chan chan bool, 10),
+		pauseDerivation:  make(chan chan error, 10),
+		resumeDerivation: make(chan chan error, 10),
+		sequencerNotifs:  &fakeSequencerStateListener{},
+		config:           &rollup.Config{BlockTime: 2},
+		driverConfig:     &Config{SequencerEnabled: true, SequencerStopped: true},
+		driverCtx:        driverCtx,
+		driverCancel:     driverCancel,
+		loopCtx:          loopCtx,
+		loopCancel:       loopCancel,
+		log:              logger,
+		snapshotLog:      logger,
+		sequencer:        &fakeSequencer{},
+		metrics:          metrics,
+		l1HeadSig:        make(chan eth.L1BlockRef, 10),
+		l1SafeSig:        make(chan eth.L1BlockRef, 10),
+		l1FinalizedSig:   make(chan eth.L1BlockRef, 10),
+		unsafeL2Payloads: make(chan *eth.ExecutionPayload, 10),
+	}
+	require.NoError(t, d.Start())
+	t.Cleanup(func() {
+		require.NoError(t, d.Close(context.Background()))
+	})
+	require.Eventually(t, func() bool { return derivation.steps.Load() == 1 }, time.Second, time.Millisecond)
+	return d, derivation
+}
+
+func TestDriverPauseDerivation(t *testing.T) {
+	d, derivation := startTestDriver(t)
+	ctx := context.Background()
+
+	require.NoError(t, d.PauseDerivation(ctx))
+	require.ErrorContains(t, d.PauseDerivation(ctx), "already paused")
+	status, err := d.SyncStatus(ctx)
+	require.NoError(t, err)
+	require.True(t, status.DerivationPaused)
+
+	// L1 heads are still tracked, but the step requests they trigger are dropped
+	require.NoError(t, d.OnL1Head(ctx, eth.L1BlockRef{Number: 1}))
+	require.Eventually(t, func() bool {
+		status, err := d.SyncStatus(ctx)
+		return err == nil && status.HeadL1.Number == 1
+	}, time.Second, time.Millisecond)
+	require.Never(t, func() bool { return derivation.steps.Load() > 1 }, 100*time.Millisecond, time.Millisecond)
+
+	require.ErrorContains(t, d.StartSequencer(ctx, common.Hash{}), "derivation must be resumed")
+
+	// resuming catches up with a new step
+	require.NoError(t, d.ResumeDerivation(ctx))
+	require.ErrorContains(t, d.ResumeDerivation(ctx), "not paused")
+	require.Eventually(t, func() bool { return derivation.steps.Load() == 2 }, time.Second, time.Millisecond)
+	status, err = d.SyncStatus(ctx)
+	require.NoError(t, err)
+	require.False(t, status.DerivationPaused)
+}
+
+func TestDriverPauseWhileSequencing(t *testing.T) {
+	d, _ := startTestDriver(t)
+	ctx := context.Background()
+	require.NoError(t, d.StartSequencer(ctx, common.Hash{}))
+	require.ErrorContains(t, d.PauseDerivation(ctx), "sequencer must be stopped")
+}
@@ -1,11 +1,13 @@
 package opnode
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"strings"
 
@@ -27,7 +29,8 @@ import (
 )
 
 // NewConfig creates a Config from the provided flags or environment variables.
-func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
+// The cancel function is set as the Config.Cancel, for the node to request its own shutdown.
+func NewConfig(ctx *cli.Context, log log.Logger, cancel context.CancelCauseFunc) (*node.Config, error) {
 	if err := flags.CheckRequired(ctx); err != nil {
 		return nil, err
 	}
@@ -56,7 +59,10 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 		return nil, fmt.Errorf("failed to load p2p config: %w", err)
 	}
 
-	l1Endpoint := NewL1EndpointConfig(ctx)
+	l1Endpoint, err := NewL1EndpointConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load l1 endpoint info: %w", err)
+	}
 
 	l2Endpoint, err := NewL2EndpointConfig(ctx, log)
 	if err != nil {
@@ -82,6 +88,9 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 			ListenAddr:  ctx.String(flags.RPCListenAddr.Name),
 			ListenPort:  ctx.Int(flags.RPCListenPort.Name),
 			EnableAdmin: ctx.Bool(flags.RPCEnableAdmin.Name),
+
+			HealthyLagBlocks: ctx.Uint64(flags.RPCHealthyLagBlocks.Name),
+			HealthyLagGrace:  ctx.Duration(flags.RPCHealthyLagGrace.Name),
 		},
 		Metrics: node.MetricsConfig{
 			Enabled:    ctx.Bool(flags.MetricsEnabledFlag.Name),
@@ -97,6 +106,8 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 		P2PSigner:                   p2pSignerSetup,
 		L1EpochPollInterval:         ctx.Duration(flags.L1EpochPollIntervalFlag.Name),
 		RuntimeConfigReloadInterval: ctx.Duration(flags.RuntimeConfigReloadIntervalFlag.Name),
+		SyncProgressLogInterval:     ctx.Duration(flags.SyncProgressLogInterval.Name),
+		StatusLogInterval:           ctx.Duration(flags.StatusLogInterval.Name),
 		Heartbeat: node.HeartbeatConfig{
 			Enabled: ctx.Bool(flags.HeartbeatEnabledFlag.Name),
 			Moniker: ctx.String(flags.HeartbeatMonikerFlag.Name),
@@ -104,8 +115,14 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 		},
 		ConfigPersistence: configPersistence,
 		Sync:              *syncConfig,
+		Cancel:            cancel,
 		RollupHalt:        haltOption,
+		ExitAfterCatchUp:  ctx.Bool(flags.ExitAfterCatchUp.Name),
 		RethDBPath:        ctx.String(flags.L1RethDBPath.Name),
+
+		L1SlowRequestThreshold: ctx.Duration(flags.L1SlowRequestThreshold.Name),
+		MaxL1HeadAge:           ctx.Duration(flags.L1MaxHeadAge.Name),
+		MaxL1HeadAgeReadiness:  ctx.Bool(flags.L1MaxHeadAgeReadiness.Name),
 	}
 
 	if err := cfg.LoadPersisted(log); err != nil {
@@ -118,8 +135,8 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 	return cfg, nil
 }
 
-func NewL1EndpointConfig(ctx *cli.Context) *node.L1EndpointConfig {
-	return &node.L1EndpointConfig{
+func NewL1EndpointConfig(ctx *cli.Context) (*node.L1EndpointConfig, error) {
+	cfg := &node.L1EndpointConfig{
 		L1NodeAddr:       ctx.String(flags.L1NodeAddr.Name),
 		L1TrustRPC:       ctx.Bool(flags.L1TrustRPC.Name),
 		L1RPCKind:        sources.RPCProviderKind(strings.ToLower(ctx.String(flags.L1RPCProviderKind.Name))),
@@ -127,7 +144,44 @@ func NewL1EndpointConfig(ctx *cli.Context) *node.L1EndpointConfig {
 		BatchSize:        ctx.Int(flags.L1RPCMaxBatchSize.Name),
 		HttpPollInterval: ctx.Duration(flags.L1HTTPPollInterval.Name),
 		MaxConcurrency:   ctx.Int(flags.L1RPCMaxConcurrency.Name),
+		CacheSize:        ctx.Uint(flags.L1CacheSize.Name),
+		TLS: node.ClientTLSConfig{
+			CACert: ctx.String(flags.L1TLSCaCert.Name),
+			Cert:   ctx.String(flags.L1TLSCert.Name),
+			Key:    ctx.String(flags.L1TLSKey.Name),
+		},
+		HTTPProxy:   ctx.String(flags.L1HTTPProxy.Name),
+		DialTimeout: ctx.Duration(flags.L1DialTimeout.Name),
 	}
+	// Unlike the L2 engine secret, the L1 secret is optional and never generated:
+	// it has to match the secret of the L1 RPC provider.
+	if fileName := strings.TrimSpace(ctx.String(flags.L1JWTSecret.Name)); fileName != "" {
+		data, err := os.ReadFile(fileName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read L1 jwt secret: %w", err)
+		}
+		secret, err := parseJWTSecret(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid jwt secret in path %s: %w", fileName, err)
+		}
+		cfg.L1JWTSecret = &secret
+	}
+	headers, err := parseHeaders(ctx.StringSlice(flags.L1RPCHeader.Name))
+	if err != nil {
+		return nil, fmt.Errorf("invalid L1 RPC header: %w", err)
+	}
+	cfg.Headers = headers
+	return cfg, nil
+}
+
+// parseJWTSecret parses a 32 byte hex-encoded JWT secret, as used for Engine API and RPC authentication.
+func parseJWTSecret(data []byte) (out [32]byte, err error) {
+	jwtSecret := common.FromHex(strings.TrimSpace(string(data)))
+	if len(jwtSecret) != 32 {
+		return out, errors.New("not 32 hex-formatted bytes")
+	}
+	copy(out[:], jwtSecret)
+	return out, nil
 }
 
 func NewL2EndpointConfig(ctx *cli.Context, log log.Logger) (*node.L2EndpointConfig, error) {
@@ -139,11 +193,10 @@ func NewL2EndpointConfig(ctx *cli.Context, log log.Logger) (*node.L2EndpointConf
 		return nil, fmt.Errorf("file-name of jwt secret is empty")
 	}
 	if data, err := os.ReadFile(fileName); err == nil {
-		jwtSecret := common.FromHex(strings.TrimSpace(string(data)))
-		if len(jwtSecret) != 32 {
-			return nil, fmt.Errorf("invalid jwt secret in path %s, not 32 hex-formatted bytes", fileName)
+		secret, err = parseJWTSecret(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid jwt secret in path %s, %w", fileName, err)
 		}
-		copy(secret[:], jwtSecret)
 	} else {
 		log.Warn("Failed to read JWT secret from file, generating a new one now. Configure L2 geth with --authrpc.jwt-secret=" + fmt.Sprintf("%q", fileName))
 		if _, err := io.ReadFull(rand.Reader, secret[:]); err != nil {
@@ -154,12 +207,40 @@ func NewL2EndpointConfig(ctx *cli.Context, log log.Logger) (*node.L2EndpointConf
 		}
 	}
 
+	headers, err := parseHeaders(ctx.StringSlice(flags.L2RPCHeader.Name))
+	if err != nil {
+		return nil, fmt.Errorf("invalid L2 RPC header: %w", err)
+	}
+
 	return &node.L2EndpointConfig{
 		L2EngineAddr:      l2Addr,
 		L2EngineJWTSecret: secret,
+		TLS: node.ClientTLSConfig{
+			CACert: ctx.String(flags.L2TLSCaCert.Name),
+			Cert:   ctx.String(flags.L2TLSCert.Name),
+			Key:    ctx.String(flags.L2TLSKey.Name),
+		},
+		HTTPProxy:              ctx.String(flags.L2HTTPProxy.Name),
+		Headers:                headers,
+		L2EnginePayloadTimeout: ctx.Duration(flags.L2EnginePayloadTimeout.Name),
+		DialTimeout:            ctx.Duration(flags.L2DialTimeout.Name),
+		CheckModules:           ctx.Bool(flags.L2CheckModules.Name),
 	}, nil
 }
 
+// parseHeaders parses "Name: value" formatted HTTP headers.
+func parseHeaders(values []string) (http.Header, error) {
+	headers := make(http.Header)
+	for _, v := range values {
+		name, value, ok := strings.Cut(v, ":")
+		if !ok {
+			return nil, fmt.Errorf("header %q is not formatted as \"Name: value\"", v)
+		}
+		headers.Add(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+	return headers, nil
+}
+
 func NewConfigPersistence(ctx *cli.Context) node.ConfigPersistence {
 	stateFile := ctx.String(flags.RPCAdminPersistence.Name)
 	if stateFile == "" {
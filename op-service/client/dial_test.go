@@ -1,12 +1,20 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
 )
 
 func TestIsURLAvailableLocal(t *testing.T) {
@@ -24,7 +32,14 @@ func TestIsURLAvailableLocal(t *testing.T) {
 
 	// Fail open if we don't recognize the scheme
 	require.True(t, IsURLAvailable("mailto://example.com"))
+}
 
+func TestIsURLAvailableIPC(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "geth.ipc")
+	require.False(t, IsURLAvailable(path), "socket does not exist yet")
+
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+	require.True(t, IsURLAvailable(path))
 }
 
 func TestIsURLAvailableNonLocal(t *testing.T) {
@@ -54,3 +69,32 @@ func TestIsURLAvailableNonLocal(t *testing.T) {
 	require.False(t, IsURLAvailable("wss://fakedomainnamethatdoesnotexistandshouldneverexist.com"))
 	require.False(t, IsURLAvailable("wss://fakedomainnamethatdoesnotexistandshouldneverexist.com/hello"))
 }
+
+func TestDialAttemptTimeout(t *testing.T) {
+	// Accept TCP connections, but never respond to the websocket handshake.
+	listener, err := net.Listen("tcp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		var conns []net.Conn
+		defer func() {
+			for _, conn := range conns {
+				conn.Close()
+			}
+		}()
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			conns = append(conns, conn)
+		}
+	}()
+
+	addr := "ws://" + listener.Addr().String()
+	start := time.Now()
+	_, err = NewRPC(context.Background(), testlog.Logger(t, log.LvlInfo), addr,
+		WithDialBackoff(1), WithDialAttemptTimeout(100*time.Millisecond))
+	require.Error(t, err)
+	require.Less(t, time.Since(start), 5*time.Second, "dial should not hang on a non-responsive endpoint")
+}
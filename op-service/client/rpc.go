@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"os"
 	"regexp"
 	"time"
 
@@ -20,6 +21,10 @@ import (
 
 var httpRegex = regexp.MustCompile("^http(s)?://")
 
+// DefaultDialAttemptTimeout bounds each individual dial attempt,
+// so an endpoint that accepts connections but never responds cannot block the dial indefinitely.
+const DefaultDialAttemptTimeout = 30 * time.Second
+
 type RPC interface {
 	Close()
 	CallContext(ctx context.Context, result any, method string, args ...any) error
@@ -31,6 +36,7 @@ type rpcConfig struct {
 	gethRPCOptions   []rpc.ClientOption
 	httpPollInterval time.Duration
 	backoffAttempts  int
+	dialTimeout      time.Duration
 	limit            float64
 	burst            int
 }
@@ -46,6 +52,15 @@ func WithDialBackoff(attempts int) RPCOption {
 	}
 }
 
+// WithDialAttemptTimeout configures the timeout of each individual dial attempt.
+// A timed out attempt is retried like any other failed attempt, see WithDialBackoff.
+func WithDialAttemptTimeout(timeout time.Duration) RPCOption {
+	return func(cfg *rpcConfig) error {
+		cfg.dialTimeout = timeout
+		return nil
+	}
+}
+
 // WithHttpPollInterval configures the RPC to poll at the given rate, in case RPC subscriptions are not available.
 func WithHttpPollInterval(duration time.Duration) RPCOption {
 	return func(cfg *rpcConfig) error {
@@ -74,7 +89,7 @@ func WithRateLimit(rateLimit float64, burst int) RPCOption {
 
 // NewRPC returns the correct client.RPC instance for a given RPC url.
 func NewRPC(ctx context.Context, lgr log.Logger, addr string, opts ...RPCOption) (RPC, error) {
-	var cfg rpcConfig
+	cfg := rpcConfig{dialTimeout: DefaultDialAttemptTimeout}
 	for i, opt := range opts {
 		if err := opt(&cfg); err != nil {
 			return nil, fmt.Errorf("rpc option %d failed to apply to RPC config: %w", i, err)
@@ -85,7 +100,7 @@ func NewRPC(ctx context.Context, lgr log.Logger, addr string, opts ...RPCOption)
 		cfg.backoffAttempts = 1
 	}
 
-	underlying, err := dialRPCClientWithBackoff(ctx, lgr, addr, cfg.backoffAttempts, cfg.gethRPCOptions...)
+	underlying, err := dialRPCClientWithBackoff(ctx, lgr, addr, cfg.backoffAttempts, cfg.dialTimeout, cfg.gethRPCOptions...)
 	if err != nil {
 		return nil, err
 	}
@@ -108,14 +123,21 @@ func NewRPCWithClient(ctx context.Context, lgr log.Logger, addr string, underlyi
 }
 
 // Dials a JSON-RPC endpoint repeatedly, with a backoff, until a client connection is established. Auth is optional.
-func dialRPCClientWithBackoff(ctx context.Context, log log.Logger, addr string, attempts int, opts ...rpc.ClientOption) (*rpc.Client, error) {
+// Each attempt is bounded by the given timeout, if positive.
+func dialRPCClientWithBackoff(ctx context.Context, log log.Logger, addr string, attempts int, timeout time.Duration, opts ...rpc.ClientOption) (*rpc.Client, error) {
 	bOff := retry.Exponential()
 	return retry.Do(ctx, attempts, bOff, func() (*rpc.Client, error) {
 		if !IsURLAvailable(addr) {
 			log.Warn("failed to dial address, but may connect later", "addr", addr)
 			return nil, fmt.Errorf("address unavailable (%s)", addr)
 		}
-		client, err := rpc.DialOptions(ctx, addr, opts...)
+		dialCtx := ctx
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			dialCtx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		client, err := rpc.DialOptions(dialCtx, addr, opts...)
 		if err != nil {
 			return nil, fmt.Errorf("failed to dial address (%s): %w", addr, err)
 		}
@@ -123,11 +145,19 @@ func dialRPCClientWithBackoff(ctx context.Context, log log.Logger, addr string,
 	})
 }
 
+// IsURLAvailable checks if the RPC at the given address can be reached,
+// by opening a TCP connection to network endpoints, or by checking that an IPC socket exists.
 func IsURLAvailable(address string) bool {
 	u, err := url.Parse(address)
 	if err != nil {
 		return false
 	}
+	if u.Scheme == "" {
+		// Without scheme the address is dialed as IPC endpoint.
+		// The socket may be created after startup, e.g. by an execution client that starts later.
+		_, err := os.Stat(address)
+		return err == nil
+	}
 	addr := u.Host
 	if u.Port() == "" {
 		switch u.Scheme {
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// maxSlowLogArgsLen limits the length of the arguments summary in slow-request logs.
+const maxSlowLogArgsLen = 200
+
+// SlowLoggingClient is a wrapper around a RPC that logs a warning for every request
+// that takes longer than the threshold. The threshold can be changed at any time, and disables the logging if 0.
+type SlowLoggingClient struct {
+	c         RPC
+	log       log.Logger
+	threshold atomic.Int64 // time.Duration
+}
+
+// NewSlowLoggingClient wraps the RPC to log requests that take longer than the given threshold.
+func NewSlowLoggingClient(c RPC, log log.Logger, threshold time.Duration) *SlowLoggingClient {
+	s := &SlowLoggingClient{c: c, log: log}
+	s.SetThreshold(threshold)
+	return s
+}
+
+// SetThreshold changes the duration after which requests are logged as slow. Logging is disabled if 0.
+func (s *SlowLoggingClient) SetThreshold(threshold time.Duration) {
+	s.threshold.Store(int64(threshold))
+}
+
+// Threshold returns the current slow-request threshold.
+func (s *SlowLoggingClient) Threshold() time.Duration {
+	return time.Duration(s.threshold.Load())
+}
+
+func (s *SlowLoggingClient) Close() {
+	s.c.Close()
+}
+
+func (s *SlowLoggingClient) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	start := time.Now()
+	err := s.c.CallContext(ctx, result, method, args...)
+	if threshold := s.Threshold(); threshold > 0 {
+		if dur := time.Since(start); dur > threshold {
+			s.log.Warn("Slow RPC request", "method", method, "args", summarizeArgs(args), "duration", dur, "err", err)
+		}
+	}
+	return err
+}
+
+func (s *SlowLoggingClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	start := time.Now()
+	err := s.c.BatchCallContext(ctx, b)
+	if threshold := s.Threshold(); threshold > 0 {
+		if dur := time.Since(start); dur > threshold && len(b) > 0 {
+			s.log.Warn("Slow RPC batch request", "size", len(b), "first_method", b[0].Method,
+				"first_args", summarizeArgs(b[0].Args), "duration", dur, "err", err)
+		}
+	}
+	return err
+}
+
+func (s *SlowLoggingClient) EthSubscribe(ctx context.Context, channel any, args ...any) (ethereum.Subscription, error) {
+	return s.c.EthSubscribe(ctx, channel, args...)
+}
+
+// summarizeArgs formats the request arguments, truncated to keep log lines short.
+func summarizeArgs(args []any) string {
+	out := fmt.Sprintf("%v", args)
+	if len(out) > maxSlowLogArgsLen {
+		out = out[:maxSlowLogArgsLen] + "..."
+	}
+	return out
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+// delayedRPC is a RPC that responds to every request after a fixed delay.
+type delayedRPC struct {
+	RPC
+	delay time.Duration
+}
+
+func (d *delayedRPC) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	time.Sleep(d.delay)
+	return nil
+}
+
+func (d *delayedRPC) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	time.Sleep(d.delay)
+	return nil
+}
+
+func TestSlowLoggingClient(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	logs := testlog.Capture(logger)
+	underlying := &delayedRPC{delay: 20 * time.Millisecond}
+	cl := NewSlowLoggingClient(underlying, logger, time.Second)
+	ctx := context.Background()
+
+	require.NoError(t, cl.CallContext(ctx, nil, "eth_getBlockByNumber", "0x1", false))
+	require.Nil(t, logs.FindLog(log.LvlWarn, "Slow RPC request"), "fast request")
+
+	cl.SetThreshold(time.Millisecond)
+	require.Equal(t, time.Millisecond, cl.Threshold())
+	require.NoError(t, cl.CallContext(ctx, nil, "eth_getBlockByNumber", "0x1", false))
+	l := logs.FindLog(log.LvlWarn, "Slow RPC request")
+	require.NotNil(t, l)
+	require.Equal(t, "eth_getBlockByNumber", l.GetContextValue("method"))
+	require.Equal(t, "[0x1 false]", l.GetContextValue("args"))
+
+	require.NoError(t, cl.BatchCallContext(ctx, []rpc.BatchElem{{Method: "eth_getTransactionReceipt"}}))
+	l = logs.FindLog(log.LvlWarn, "Slow RPC batch request")
+	require.NotNil(t, l)
+	require.Equal(t, 1, l.GetContextValue("size"))
+
+	cl.SetThreshold(0)
+	logs.Clear()
+	require.NoError(t, cl.CallContext(ctx, nil, "eth_chainId"))
+	require.Nil(t, logs.FindLog(log.LvlWarn, "Slow RPC request"), "disabled")
+}
+
+func TestSummarizeArgs(t *testing.T) {
+	require.Equal(t, "[]", summarizeArgs(nil))
+	long := summarizeArgs([]any{string(make([]byte, 300))})
+	require.Len(t, long, maxSlowLogArgsLen+len("..."))
+}
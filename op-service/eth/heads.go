@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
@@ -19,6 +20,7 @@ type NewHeadSource interface {
 
 // WatchHeadChanges wraps a new-head subscription from NewHeadSource to feed the given Tracker.
 // The ctx is only used to create the subscription, and does not affect the returned subscription.
+// Consecutive duplicate head signals are not passed on to fn.
 func WatchHeadChanges(ctx context.Context, src NewHeadSource, fn HeadSignalFn) (ethereum.Subscription, error) {
 	headChanges := make(chan *types.Header, 10)
 	sub, err := src.SubscribeNewHead(ctx, headChanges)
@@ -40,11 +42,19 @@ func WatchHeadChanges(ctx context.Context, src NewHeadSource, fn HeadSignalFn) (
 			}
 		}()
 
+		var lastHead common.Hash
 		for {
 			select {
 			case header := <-headChanges:
+				hash := header.Hash()
+				// A head that is the same as the previous one carries no new information.
+				// Note that a reorg back to an older head is still signaled, since it differs from the last head.
+				if hash == lastHead {
+					continue
+				}
+				lastHead = hash
 				fn(eventsCtx, L1BlockRef{
-					Hash:       header.Hash(),
+					Hash:       hash,
 					Number:     header.Number.Uint64(),
 					ParentHash: header.ParentHash,
 					Time:       header.Time,
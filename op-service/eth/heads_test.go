@@ -0,0 +1,55 @@
+package eth
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/stretchr/testify/require"
+)
+
+type testHeadSource struct {
+	ch chan<- *types.Header
+}
+
+func (s *testHeadSource) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	s.ch = ch
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		return nil
+	}), nil
+}
+
+func TestWatchHeadChangesDedup(t *testing.T) {
+	a := &types.Header{Number: big.NewInt(1), Extra: []byte("a")}
+	b := &types.Header{Number: big.NewInt(2), Extra: []byte("b")}
+	src := &testHeadSource{}
+	out := make(chan L1BlockRef, 10)
+	sub, err := WatchHeadChanges(context.Background(), src, func(ctx context.Context, sig L1BlockRef) {
+		out <- sig
+	})
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	// a, a is a duplicate; b, a is a reorg back to a and must be signaled again.
+	for _, h := range []*types.Header{a, a, b, b, a} {
+		src.ch <- h
+	}
+	for _, want := range []*types.Header{a, b, a} {
+		select {
+		case got := <-out:
+			require.Equal(t, want.Hash(), got.Hash)
+		case <-time.After(time.Second):
+			t.Fatal("expected head signal")
+		}
+	}
+	select {
+	case got := <-out:
+		t.Fatalf("unexpected head signal %s", got)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -40,4 +40,6 @@ type SyncStatus struct {
 	// EngineSyncTarget points to the L2 block that the execution engine is syncing to.
 	// If it is ahead from UnsafeL2, the engine is in progress of P2P sync.
 	EngineSyncTarget L2BlockRef `json:"engine_sync_target"`
+	// DerivationPaused is true when derivation has been paused through the admin API.
+	DerivationPaused bool `json:"derivation_paused"`
 }
@@ -2,6 +2,7 @@ package sources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -16,20 +17,30 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
+// DefaultEnginePayloadTimeout is the default timeout of a single forkchoice-updated or new-payload engine API call.
+const DefaultEnginePayloadTimeout = 5 * time.Second
+
 type EngineClientConfig struct {
 	L2ClientConfig
+
+	// PayloadTimeout bounds each forkchoice-updated and new-payload call,
+	// for a hung engine to not block the caller indefinitely.
+	PayloadTimeout time.Duration
 }
 
 func EngineClientDefaultConfig(config *rollup.Config) *EngineClientConfig {
 	return &EngineClientConfig{
 		// engine is trusted, no need to recompute responses etc.
 		L2ClientConfig: *L2ClientDefaultConfig(config, true),
+		PayloadTimeout: DefaultEnginePayloadTimeout,
 	}
 }
 
 // EngineClient extends L2Client with engine API bindings.
 type EngineClient struct {
 	*L2Client
+
+	payloadTimeout time.Duration
 }
 
 func NewEngineClient(client client.RPC, log log.Logger, metrics caching.Metrics, config *EngineClientConfig) (*EngineClient, error) {
@@ -38,8 +49,14 @@ func NewEngineClient(client client.RPC, log log.Logger, metrics caching.Metrics,
 		return nil, err
 	}
 
+	payloadTimeout := config.PayloadTimeout
+	if payloadTimeout <= 0 {
+		payloadTimeout = DefaultEnginePayloadTimeout
+	}
+
 	return &EngineClient{
-		L2Client: l2Client,
+		L2Client:       l2Client,
+		payloadTimeout: payloadTimeout,
 	}, nil
 }
 
@@ -54,7 +71,7 @@ func (s *EngineClient) ForkchoiceUpdate(ctx context.Context, fc *eth.ForkchoiceS
 	llog := s.log.New("state", fc)       // local logger
 	tlog := llog.New("attr", attributes) // trace logger
 	tlog.Trace("Sharing forkchoice-updated signal")
-	fcCtx, cancel := context.WithTimeout(ctx, time.Second*5)
+	fcCtx, cancel := context.WithTimeout(ctx, s.payloadTimeout)
 	defer cancel()
 	var result eth.ForkchoiceUpdatedResult
 	err := s.client.CallContext(fcCtx, &result, "engine_forkchoiceUpdatedV2", fc, attributes)
@@ -65,6 +82,10 @@ func (s *EngineClient) ForkchoiceUpdate(ctx context.Context, fc *eth.ForkchoiceS
 		}
 		return &result, nil
 	} else {
+		if errors.Is(err, context.DeadlineExceeded) {
+			llog.Warn("Forkchoice-updated signal timed out", "timeout", s.payloadTimeout)
+			return nil, fmt.Errorf("forkchoice update timed out after %s: %w", s.payloadTimeout, err)
+		}
 		llog.Warn("Failed to share forkchoice-updated signal", "err", err)
 		if rpcErr, ok := err.(rpc.Error); ok {
 			code := eth.ErrorCode(rpcErr.ErrorCode())
@@ -89,12 +110,15 @@ func (s *EngineClient) NewPayload(ctx context.Context, payload *eth.ExecutionPay
 	e := s.log.New("block_hash", payload.BlockHash)
 	e.Trace("sending payload for execution")
 
-	execCtx, cancel := context.WithTimeout(ctx, time.Second*5)
+	execCtx, cancel := context.WithTimeout(ctx, s.payloadTimeout)
 	defer cancel()
 	var result eth.PayloadStatusV1
 	err := s.client.CallContext(execCtx, &result, "engine_newPayloadV2", payload)
 	e.Trace("Received payload execution result", "status", result.Status, "latestValidHash", result.LatestValidHash, "message", result.ValidationError)
-	if err != nil {
+	if errors.Is(err, context.DeadlineExceeded) {
+		e.Error("Payload execution timed out", "timeout", s.payloadTimeout)
+		return nil, fmt.Errorf("payload execution timed out after %s: %w", s.payloadTimeout, err)
+	} else if err != nil {
 		e.Error("Payload execution failed", "err", err)
 		return nil, fmt.Errorf("failed to execute payload: %w", err)
 	}
@@ -0,0 +1,56 @@
+package sources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+// hangingRPC is an engine RPC that never responds, until the request context is done.
+type hangingRPC struct{}
+
+var _ client.RPC = hangingRPC{}
+
+func (hangingRPC) Close() {}
+
+func (hangingRPC) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (hangingRPC) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (hangingRPC) EthSubscribe(ctx context.Context, channel any, args ...any) (ethereum.Subscription, error) {
+	return nil, rpc.ErrNotificationsUnsupported
+}
+
+func TestEngineClientPayloadTimeout(t *testing.T) {
+	cfg := EngineClientDefaultConfig(&rollup.Config{SeqWindowSize: 10})
+	cfg.PayloadTimeout = 20 * time.Millisecond
+	cl, err := NewEngineClient(hangingRPC{}, testlog.Logger(t, log.LvlError), nil, cfg)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	start := time.Now()
+	_, err = cl.NewPayload(ctx, &eth.ExecutionPayload{})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorContains(t, err, "timed out")
+	require.Less(t, time.Since(start), time.Second)
+
+	_, err = cl.ForkchoiceUpdate(ctx, &eth.ForkchoiceState{}, nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorContains(t, err, "timed out")
+}
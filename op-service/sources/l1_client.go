@@ -48,6 +48,16 @@ func L1ClientDefaultConfig(config *rollup.Config, trustRPC bool, kind RPCProvide
 	}
 }
 
+// SetBlockCacheSize overrides the size of the caches that hold data per L1 block:
+// headers, receipts, transactions and payloads.
+// The block-refs cache is left untouched, to keep covering the full sync-start range.
+func (c *L1ClientConfig) SetBlockCacheSize(size int) {
+	c.ReceiptsCacheSize = size
+	c.TransactionsCacheSize = size
+	c.HeadersCacheSize = size
+	c.PayloadsCacheSize = size
+}
+
 // L1Client provides typed bindings to retrieve L1 data from an RPC source,
 // with optimized batch requests, cached results, and flag to not trust the RPC
 // (i.e. to verify all returned contents against corresponding block hashes).
@@ -2,6 +2,7 @@ package sources
 
 import (
 	"context"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -60,6 +61,30 @@ func (r *RollupClient) SequencerActive(ctx context.Context) (bool, error) {
 	return result, err
 }
 
+func (r *RollupClient) PauseDerivation(ctx context.Context) error {
+	return r.rpc.CallContext(ctx, nil, "admin_pauseDerivation")
+}
+
+func (r *RollupClient) ResumeDerivation(ctx context.Context) error {
+	return r.rpc.CallContext(ctx, nil, "admin_resumeDerivation")
+}
+
+func (r *RollupClient) RefreshL1Head(ctx context.Context) (eth.L1BlockRef, error) {
+	var out eth.L1BlockRef
+	err := r.rpc.CallContext(ctx, &out, "admin_refreshL1Head")
+	return out, err
+}
+
+func (r *RollupClient) CommonAncestor(ctx context.Context) (eth.L2BlockRef, error) {
+	var out eth.L2BlockRef
+	err := r.rpc.CallContext(ctx, &out, "admin_commonAncestor")
+	return out, err
+}
+
+func (r *RollupClient) SetL1SlowRequestThreshold(ctx context.Context, threshold time.Duration) error {
+	return r.rpc.CallContext(ctx, nil, "admin_setL1SlowRequestThreshold", threshold.String())
+}
+
 func (r *RollupClient) SetLogLevel(ctx context.Context, lvl log.Lvl) error {
 	return r.rpc.CallContext(ctx, nil, "admin_setLogLevel", lvl.String())
 }